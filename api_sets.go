@@ -1,6 +1,13 @@
 package cantor
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// [ErrUndecidable] is returned by comparisons, which can not be decided in general,
+// for example comparisons of [ImplicitSet] without a [Domain].
+var ErrUndecidable = errors.New("cantor: undecidable without a domain")
 
 // [Predicate] is a type of function that receives an element and returns a boolean value.
 // It can be used to define an [ImplicitSet].
@@ -14,6 +21,16 @@ type Predicate[T any] func(element T) bool
 // This interface is inspired by the rangefunc experiment: https://go.dev/wiki/RangefuncExperiment.
 type Iterator[T any] func(yield func(element T) (next bool))
 
+// [Domain] represents a finite and enumerable universe of elements.
+// Comparisons of sets, which can not be enumerated, like [ImplicitSet], are decided relative to a [Domain].
+// Elements outside of the domain are ignored by such comparisons.
+//
+// [Domain] is implemented by every [ReadableSet].
+type Domain[T any] interface {
+	// Elements returns an Iterator over all elements of this Domain.
+	Elements() Iterator[T]
+}
+
 // [ReadableSet] represents a collection of unique and enumerable elements, which has a limited, known size.
 // The elements can be iterated using an [Iterator].
 //
@@ -70,7 +87,7 @@ type ReadableSet[T comparable] interface {
 
 // [Set] represents a [ReadableSet], where elements can freely be added or removed.
 //
-// [Set] is directly implemented by [HashSet].
+// [Set] is directly implemented by [HashSet] and [TrieSet].
 type Set[T comparable] interface {
 	ReadableSet[T]
 
@@ -86,3 +103,33 @@ type Set[T comparable] interface {
 	// Data views derived from this set will reflect the change.
 	Remove(element T) (modified bool)
 }
+
+// [BulkSet] represents a [Set], which can efficiently add or remove many elements at once.
+// Use [AddAll], [RemoveAll], [RetainAll] and [Clear] to apply these operations to any [Set].
+//
+// [BulkSet] is directly implemented by [HashSet], [TrieSet], [Tx] and sets generated by cantor-gen.
+type BulkSet[T comparable] interface {
+	Set[T]
+
+	// AddAll adds all elements of the iterator and returns the number of elements, which were actually added.
+	//
+	// Data views derived from this set will reflect the change.
+	AddAll(iterator Iterator[T]) (modified int)
+
+	// RemoveAll removes all elements, which are contained in the other container,
+	// and returns the number of elements, which were actually removed.
+	//
+	// Data views derived from this set will reflect the change.
+	RemoveAll(other Container[T]) (modified int)
+
+	// RetainAll removes all elements, which are not contained in the other container,
+	// and returns the number of elements, which were actually removed.
+	//
+	// Data views derived from this set will reflect the change.
+	RetainAll(other Container[T]) (modified int)
+
+	// Clear removes all elements and returns the number of elements, which were actually removed.
+	//
+	// Data views derived from this set will reflect the change.
+	Clear() (modified int)
+}
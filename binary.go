@@ -0,0 +1,408 @@
+package cantor
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+)
+
+// The binary format consists of a header followed by blocks of elements.
+// The header holds binaryMagic, binaryVersion and the element kind.
+// Each block starts with the number of elements as uvarint, a block of length zero ends the encoded set.
+//
+// Integer elements of a block are sorted and written as the varint encoded first element
+// followed by the uvarint encoded deltas to their predecessor.
+// Strings are written as uvarint length followed by their bytes.
+const (
+	binaryMagic     = "CNTR"
+	binaryVersion   = 1
+	binaryBlockSize = 4096
+)
+
+type binaryKind byte
+
+const (
+	binaryKindSigned binaryKind = iota + 1
+	binaryKindUnsigned
+	binaryKindString
+)
+
+var (
+	// [ErrUnsupportedElementType] is returned when encoding or decoding sets of an element type,
+	// which is not supported by the binary format. Supported are integer and string types.
+	ErrUnsupportedElementType = errors.New("cantor: unsupported element type")
+
+	// [ErrInvalidEncoding] is returned when decoding data, which is not a valid binary encoding of a set.
+	ErrInvalidEncoding = errors.New("cantor: invalid encoding")
+)
+
+// MarshalBinary implements [encoding.BinaryMarshaler] for this [HashSet].
+// Through this, [HashSet] is also supported by [encoding/gob].
+//
+// Only sets of integer or string types can be encoded, otherwise [ErrUnsupportedElementType] is returned.
+func (set HashSet[T]) MarshalBinary() ([]byte, error) {
+	var buffer bytes.Buffer
+
+	err := NewEncoder[T](&buffer).Encode(set)
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler] for [HashSet].
+// The contents of the set are replaced by the decoded elements.
+func (set *HashSet[T]) UnmarshalBinary(data []byte) error {
+	reader := bytes.NewReader(data)
+	result := NewHashSet[T]()
+
+	err := NewDecoder[T](reader).Decode(result)
+	if errors.Is(err, io.EOF) {
+		return invalidEncoding(err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if reader.Len() > 0 {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalidEncoding, reader.Len())
+	}
+
+	*set = result
+
+	return nil
+}
+
+// [Encoder] writes sets in the binary format of this package to an [io.Writer].
+// Elements are written in blocks, so that sets of any size can be encoded with constant memory overhead.
+type Encoder[T comparable] struct {
+	writer io.Writer
+	codec  binaryCodec[T]
+	buffer []byte
+	block  []T
+}
+
+// [NewEncoder] returns a new [Encoder] writing to writer.
+func NewEncoder[T comparable](writer io.Writer) *Encoder[T] {
+	return &Encoder[T]{
+		writer: writer,
+		codec:  newBinaryCodec[T](),
+	}
+}
+
+// Encode writes all elements of set to the underlying writer.
+// Multiple sets can be written to the same writer one after another.
+func (encoder *Encoder[T]) Encode(set ReadableSet[T]) (err error) {
+	if encoder.codec.kind == 0 {
+		return encoder.codec.unsupported()
+	}
+
+	encoder.buffer = append(append(encoder.buffer[:0], binaryMagic...), binaryVersion, byte(encoder.codec.kind))
+	encoder.block = encoder.block[:0]
+
+	set.Elements()(func(element T) (next bool) {
+		encoder.block = append(encoder.block, element)
+
+		if len(encoder.block) == binaryBlockSize {
+			err = encoder.flush()
+		}
+
+		return err == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(encoder.block) > 0 {
+		err = encoder.flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	encoder.buffer = appendUvarint(encoder.buffer, 0)
+
+	return encoder.write()
+}
+
+func (encoder *Encoder[T]) flush() error {
+	encoder.buffer = appendUvarint(encoder.buffer, uint64(len(encoder.block)))
+	encoder.buffer = encoder.codec.appendBlock(encoder.buffer, encoder.block)
+	encoder.block = encoder.block[:0]
+
+	return encoder.write()
+}
+
+func (encoder *Encoder[T]) write() error {
+	_, err := encoder.writer.Write(encoder.buffer)
+	encoder.buffer = encoder.buffer[:0]
+
+	return err
+}
+
+// [Decoder] reads sets in the binary format of this package from an [io.Reader].
+// Elements are read block by block, so that sets of any size can be decoded with constant memory overhead.
+//
+// If the reader does not implement [io.ByteReader], it is buffered
+// and the [Decoder] might read data beyond the encoded sets.
+type Decoder[T comparable] struct {
+	reader binaryReader
+	codec  binaryCodec[T]
+}
+
+type binaryReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// [NewDecoder] returns a new [Decoder] reading from reader.
+func NewDecoder[T comparable](reader io.Reader) *Decoder[T] {
+	byteReader, ok := reader.(binaryReader)
+	if !ok {
+		byteReader = bufio.NewReader(reader)
+	}
+
+	return &Decoder[T]{
+		reader: byteReader,
+		codec:  newBinaryCodec[T](),
+	}
+}
+
+// Decode reads the next encoded set from the underlying reader and adds all its elements to set.
+// If no set is left to decode, [io.EOF] is returned.
+func (decoder *Decoder[T]) Decode(set Set[T]) error {
+	if decoder.codec.kind == 0 {
+		return decoder.codec.unsupported()
+	}
+
+	err := decoder.readHeader()
+	if err != nil {
+		return err
+	}
+
+	for {
+		size, err := binary.ReadUvarint(decoder.reader)
+		if err != nil {
+			return invalidEncoding(err)
+		}
+
+		if size == 0 {
+			return nil
+		}
+
+		err = decoder.codec.readBlock(decoder.reader, size, set)
+		if err != nil {
+			return invalidEncoding(err)
+		}
+	}
+}
+
+func (decoder *Decoder[T]) readHeader() error {
+	header := make([]byte, len(binaryMagic)+2)
+
+	_, err := io.ReadFull(decoder.reader, header)
+	if errors.Is(err, io.EOF) {
+		return io.EOF
+	}
+
+	if err != nil {
+		return invalidEncoding(err)
+	}
+
+	switch {
+	case string(header[:len(binaryMagic)]) != binaryMagic:
+		return fmt.Errorf("%w: missing header", ErrInvalidEncoding)
+	case header[len(binaryMagic)] != binaryVersion:
+		return fmt.Errorf("%w: unsupported version %d", ErrInvalidEncoding, header[len(binaryMagic)])
+	case binaryKind(header[len(binaryMagic)+1]) != decoder.codec.kind:
+		return fmt.Errorf("%w: element kind %d does not match %s",
+			ErrInvalidEncoding, header[len(binaryMagic)+1], decoder.codec.typ)
+	}
+
+	return nil
+}
+
+func invalidEncoding(err error) error {
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+
+	return fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+}
+
+// binaryCodec converts elements of type T from and to their binary representation using reflection.
+type binaryCodec[T comparable] struct {
+	typ  reflect.Type
+	kind binaryKind
+}
+
+func newBinaryCodec[T comparable]() binaryCodec[T] {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	codec := binaryCodec[T]{typ: typ}
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		codec.kind = binaryKindSigned
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		codec.kind = binaryKindUnsigned
+	case reflect.String:
+		codec.kind = binaryKindString
+	}
+
+	return codec
+}
+
+func (codec binaryCodec[T]) unsupported() error {
+	return fmt.Errorf("%w: %s", ErrUnsupportedElementType, codec.typ)
+}
+
+func (codec binaryCodec[T]) appendBlock(buffer []byte, block []T) []byte {
+	if codec.kind == binaryKindString {
+		for _, element := range block {
+			value := reflect.ValueOf(element).String()
+			buffer = append(appendUvarint(buffer, uint64(len(value))), value...)
+		}
+
+		return buffer
+	}
+
+	values := make([]uint64, len(block))
+	for i, element := range block {
+		values[i] = codec.toUint64(element)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		if codec.kind == binaryKindSigned {
+			return int64(values[i]) < int64(values[j])
+		}
+
+		return values[i] < values[j]
+	})
+
+	buffer = codec.appendFirst(buffer, values[0])
+	for i := 1; i < len(values); i++ {
+		buffer = appendUvarint(buffer, values[i]-values[i-1])
+	}
+
+	return buffer
+}
+
+func (codec binaryCodec[T]) appendFirst(buffer []byte, value uint64) []byte {
+	if codec.kind == binaryKindSigned {
+		var scratch [binary.MaxVarintLen64]byte
+
+		return append(buffer, scratch[:binary.PutVarint(scratch[:], int64(value))]...)
+	}
+
+	return appendUvarint(buffer, value)
+}
+
+func (codec binaryCodec[T]) readBlock(reader binaryReader, size uint64, set Set[T]) error {
+	if codec.kind == binaryKindString {
+		return codec.readStrings(reader, size, set)
+	}
+
+	var (
+		value uint64
+		err   error
+	)
+
+	for i := uint64(0); i < size; i++ {
+		value, err = codec.readValue(reader, i == 0, value)
+		if err != nil {
+			return err
+		}
+
+		element, err := codec.fromUint64(value)
+		if err != nil {
+			return err
+		}
+
+		set.Add(element)
+	}
+
+	return nil
+}
+
+func (codec binaryCodec[T]) readValue(reader binaryReader, first bool, previous uint64) (uint64, error) {
+	if first && codec.kind == binaryKindSigned {
+		value, err := binary.ReadVarint(reader)
+
+		return uint64(value), err
+	}
+
+	if first {
+		return binary.ReadUvarint(reader)
+	}
+
+	delta, err := binary.ReadUvarint(reader)
+
+	return previous + delta, err
+}
+
+func (codec binaryCodec[T]) readStrings(reader binaryReader, size uint64, set Set[T]) error {
+	for i := uint64(0); i < size; i++ {
+		length, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return err
+		}
+
+		// the buffer only grows with the data actually read, so corrupted lengths cannot cause large allocations
+		var builder bytes.Buffer
+
+		_, err = io.CopyN(&builder, reader, int64(length))
+		if err != nil {
+			return err
+		}
+
+		element := reflect.New(codec.typ).Elem()
+		element.SetString(builder.String())
+		set.Add(element.Interface().(T))
+	}
+
+	return nil
+}
+
+func (codec binaryCodec[T]) toUint64(element T) uint64 {
+	value := reflect.ValueOf(element)
+
+	if codec.kind == binaryKindSigned {
+		return uint64(value.Int())
+	}
+
+	return value.Uint()
+}
+
+func (codec binaryCodec[T]) fromUint64(value uint64) (result T, err error) {
+	element := reflect.New(codec.typ).Elem()
+
+	if codec.kind == binaryKindSigned {
+		if element.OverflowInt(int64(value)) {
+			return result, fmt.Errorf("value %d overflows %s", int64(value), codec.typ)
+		}
+
+		element.SetInt(int64(value))
+	} else {
+		if element.OverflowUint(value) {
+			return result, fmt.Errorf("value %d overflows %s", value, codec.typ)
+		}
+
+		element.SetUint(value)
+	}
+
+	return element.Interface().(T), nil
+}
+
+func appendUvarint(buffer []byte, value uint64) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+
+	return append(buffer, scratch[:binary.PutUvarint(scratch[:], value)]...)
+}
@@ -0,0 +1,279 @@
+package cantor_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/testutils"
+)
+
+type userID uint32
+
+func assertBinaryRoundTrip[T comparable](t *testing.T, expected cantor.HashSet[T]) {
+	t.Helper()
+
+	data, err := expected.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := cantor.NewHashSet[T]()
+
+	err = actual.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !actual.Equals(expected) {
+		t.Errorf("expected %v but got %v", expected, actual)
+	}
+}
+
+func TestHashSet_MarshalBinary(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assertBinaryRoundTrip(t, cantor.NewHashSet[int]())
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		assertBinaryRoundTrip(t, cantor.NewHashSet(-5, 0, 3, math.MaxInt64, math.MinInt64))
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		assertBinaryRoundTrip(t, cantor.NewHashSet[uint64](0, 7, math.MaxUint64))
+	})
+
+	t.Run("named", func(t *testing.T) {
+		assertBinaryRoundTrip(t, cantor.NewHashSet[userID](1, 2, 3))
+	})
+
+	t.Run("all bytes", func(t *testing.T) {
+		assertBinaryRoundTrip(t, cantor.NewHashSet(testutils.AllBytes()...))
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		assertBinaryRoundTrip(t, cantor.NewHashSet("", "a", "{b, c}", "ü"))
+	})
+
+	t.Run("multiple blocks", func(t *testing.T) {
+		set := cantor.NewHashSet[int]()
+		for i := 0; i < 10000; i++ {
+			set.Add(i * 3)
+		}
+
+		assertBinaryRoundTrip(t, set)
+	})
+
+	t.Run("compact integers", func(t *testing.T) {
+		set := cantor.NewHashSet[int64]()
+		for i := int64(0); i < 10000; i++ {
+			set.Add(1_000_000_000 + i)
+		}
+
+		data, err := set.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(data) > 10100 {
+			t.Errorf("expected about one byte per element but got %d bytes", len(data))
+		}
+	})
+
+	t.Run("unsupported element type", func(t *testing.T) {
+		_, err := cantor.NewHashSet(testutils.Person{}).MarshalBinary()
+		if !errors.Is(err, cantor.ErrUnsupportedElementType) {
+			t.Errorf("expected ErrUnsupportedElementType but got %v", err)
+		}
+	})
+}
+
+func TestHashSet_UnmarshalBinary(t *testing.T) {
+	valid, _ := cantor.NewHashSet[int8](1, 2).MarshalBinary()
+	large, _ := cantor.NewHashSet[int64](300, -300).MarshalBinary()
+	largeUnsigned, _ := cantor.NewHashSet[uint64](300).MarshalBinary()
+	strings, _ := cantor.NewHashSet("abc").MarshalBinary()
+
+	tests := map[string][]byte{
+		"empty":            nil,
+		"truncated header": valid[:3],
+		"missing header":   []byte("XXXX\x01\x01\x00"),
+		"unknown version":  []byte("CNTR\x02\x01\x00"),
+		"kind mismatch":    strings,
+		"truncated":        valid[:len(valid)-2],
+		"missing end":      valid[:len(valid)-1],
+		"trailing bytes":   append(append([]byte{}, valid...), 0),
+		"signed overflow":  large,
+	}
+
+	for name, data := range tests {
+		data := data
+
+		t.Run(name, func(t *testing.T) {
+			set := cantor.NewHashSet[int8](5)
+
+			err := set.UnmarshalBinary(data)
+			if !errors.Is(err, cantor.ErrInvalidEncoding) {
+				t.Errorf("expected ErrInvalidEncoding but got %v", err)
+			}
+
+			if !set.Equals(cantor.NewHashSet[int8](5)) {
+				t.Errorf("set was modified: %v", set)
+			}
+		})
+	}
+
+	t.Run("unsigned overflow", func(t *testing.T) {
+		set := cantor.NewHashSet[uint8]()
+
+		err := set.UnmarshalBinary(largeUnsigned)
+		if !errors.Is(err, cantor.ErrInvalidEncoding) {
+			t.Errorf("expected ErrInvalidEncoding but got %v", err)
+		}
+	})
+
+	t.Run("truncated string", func(t *testing.T) {
+		set := cantor.NewHashSet[string]()
+
+		err := set.UnmarshalBinary(strings[:len(strings)-2])
+		if !errors.Is(err, cantor.ErrInvalidEncoding) {
+			t.Errorf("expected ErrInvalidEncoding but got %v", err)
+		}
+	})
+
+	t.Run("truncated string length", func(t *testing.T) {
+		set := cantor.NewHashSet[string]()
+
+		err := set.UnmarshalBinary([]byte("CNTR\x01\x03\x01"))
+		if !errors.Is(err, cantor.ErrInvalidEncoding) {
+			t.Errorf("expected ErrInvalidEncoding but got %v", err)
+		}
+	})
+
+	t.Run("unsupported element type", func(t *testing.T) {
+		set := cantor.NewHashSet[testutils.Person]()
+
+		err := set.UnmarshalBinary(valid)
+		if !errors.Is(err, cantor.ErrUnsupportedElementType) {
+			t.Errorf("expected ErrUnsupportedElementType but got %v", err)
+		}
+	})
+
+	t.Run("replaces contents", func(t *testing.T) {
+		set := cantor.NewHashSet[int8](5)
+
+		err := set.UnmarshalBinary(valid)
+		if err != nil || !set.Equals(cantor.NewHashSet[int8](1, 2)) {
+			t.Errorf("expected {1, 2} but got %v (%v)", set, err)
+		}
+	})
+}
+
+func TestHashSet_gob(t *testing.T) {
+	type message struct {
+		Name string
+		IDs  cantor.HashSet[userID]
+	}
+
+	var (
+		buffer   bytes.Buffer
+		expected = message{Name: "admins", IDs: cantor.NewHashSet[userID](1, 5)}
+		actual   message
+	)
+
+	err := gob.NewEncoder(&buffer).Encode(expected)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = gob.NewDecoder(&buffer).Decode(&actual)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if actual.Name != expected.Name || !actual.IDs.Equals(expected.IDs) {
+		t.Errorf("expected %v but got %v", expected, actual)
+	}
+}
+
+type failingWriter struct {
+	writes int
+}
+
+func (writer *failingWriter) Write(data []byte) (int, error) {
+	if writer.writes == 0 {
+		return 0, io.ErrShortWrite
+	}
+
+	writer.writes--
+
+	return len(data), nil
+}
+
+func TestEncoder(t *testing.T) {
+	t.Run("stream", func(t *testing.T) {
+		var buffer bytes.Buffer
+
+		encoder := cantor.NewEncoder[string](&buffer)
+		expected := []cantor.HashSet[string]{
+			cantor.NewHashSet("a", "b"),
+			cantor.NewHashSet[string](),
+			cantor.NewHashSet("c"),
+		}
+
+		for _, set := range expected {
+			err := encoder.Encode(set.Union(cantor.NewHashSet[string]()))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		// io.MultiReader does not implement io.ByteReader and causes the decoder to buffer
+		decoder := cantor.NewDecoder[string](io.MultiReader(&buffer))
+
+		for _, set := range expected {
+			actual := cantor.NewHashSet[string]()
+
+			err := decoder.Decode(actual)
+			if err != nil || !actual.Equals(set) {
+				t.Errorf("expected %v but got %v (%v)", set, actual, err)
+			}
+		}
+
+		err := decoder.Decode(cantor.NewHashSet[string]())
+		if !errors.Is(err, io.EOF) {
+			t.Errorf("expected io.EOF but got %v", err)
+		}
+	})
+
+	t.Run("failing writer", func(t *testing.T) {
+		large := cantor.NewHashSet[int]()
+		for i := 0; i < 5000; i++ {
+			large.Add(i)
+		}
+
+		tests := map[string]struct {
+			set    cantor.HashSet[int]
+			writes int
+		}{
+			"full block": {set: large, writes: 0},
+			"last block": {set: large, writes: 1},
+			"end":        {set: cantor.NewHashSet(1), writes: 1},
+		}
+
+		for name, test := range tests {
+			test := test
+
+			t.Run(name, func(t *testing.T) {
+				err := cantor.NewEncoder[int](&failingWriter{writes: test.writes}).Encode(test.set)
+				if !errors.Is(err, io.ErrShortWrite) {
+					t.Errorf("expected io.ErrShortWrite but got %v", err)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package cantor
+
+// [AddAll] adds all elements of the iterator to the set and returns the number of elements, which were actually added.
+// If the set implements [BulkSet], its AddAll method is used. Otherwise, the elements are added one by one.
+//
+// Data views derived from the set will reflect the change.
+func AddAll[T comparable](set Set[T], iterator Iterator[T]) (modified int) {
+	if bulkSet, ok := set.(BulkSet[T]); ok {
+		return bulkSet.AddAll(iterator)
+	}
+
+	iterator(func(element T) bool {
+		if set.Add(element) {
+			modified++
+		}
+
+		return true
+	})
+
+	return modified
+}
+
+// [RemoveAll] removes all elements, which are contained in the other container, from the set
+// and returns the number of elements, which were actually removed.
+// If the set implements [BulkSet], its RemoveAll method is used. Otherwise, the elements are removed one by one.
+//
+// Data views derived from the set will reflect the change.
+func RemoveAll[T comparable](set Set[T], other Container[T]) (modified int) {
+	if bulkSet, ok := set.(BulkSet[T]); ok {
+		return bulkSet.RemoveAll(other)
+	}
+
+	return removeEach(set, set.Intersect(other))
+}
+
+// [RetainAll] removes all elements, which are not contained in the other container, from the set
+// and returns the number of elements, which were actually removed.
+// If the set implements [BulkSet], its RetainAll method is used. Otherwise, the elements are removed one by one.
+//
+// Data views derived from the set will reflect the change.
+func RetainAll[T comparable](set Set[T], other Container[T]) (modified int) {
+	if bulkSet, ok := set.(BulkSet[T]); ok {
+		return bulkSet.RetainAll(other)
+	}
+
+	return removeEach(set, set.Difference(other))
+}
+
+// [Clear] removes all elements from the set and returns the number of elements, which were actually removed.
+// If the set implements [BulkSet], its Clear method is used. Otherwise, the elements are removed one by one.
+//
+// Data views derived from the set will reflect the change.
+func Clear[T comparable](set Set[T]) (modified int) {
+	if bulkSet, ok := set.(BulkSet[T]); ok {
+		return bulkSet.Clear()
+	}
+
+	return removeEach[T](set, set)
+}
+
+// removeEach evaluates elements before removing them from the set, since they might be a view of the set.
+func removeEach[T comparable](set Set[T], elements ReadableSet[T]) (modified int) {
+	for element := range NewHashSetFromIterator(elements.Elements()) {
+		if set.Remove(element) {
+			modified++
+		}
+	}
+
+	return modified
+}
@@ -0,0 +1,31 @@
+package cantor_test
+
+import (
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/sets"
+)
+
+// plainSet only exposes the methods of [cantor.Set], so the bulk operations fall back to single elements.
+type plainSet struct {
+	cantor.Set[byte]
+}
+
+func TestBulk_fallback(t *testing.T) {
+	sets.RunTestsForSet_Bulk(t, func(elements ...byte) cantor.Set[byte] {
+		return plainSet{Set: cantor.NewHashSet(elements...)}
+	})
+}
+
+func TestBulk_bulkSets(t *testing.T) {
+	for name, set := range map[string]cantor.Set[string]{
+		"HashSet": cantor.NewHashSet[string](),
+		"TrieSet": cantor.NewTrieSet(),
+		"Tx":      cantor.NewTx[string](cantor.NewHashSet[string]()),
+	} {
+		if _, ok := set.(cantor.BulkSet[string]); !ok {
+			t.Errorf("expected %s to implement BulkSet", name)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// generatedHeader marks files generated by this command. Such files are ignored when loading a package.
+const generatedHeader = "// Code generated by \"cantor-gen"
+
+// enum describes an enum-like type and its constants.
+type enum struct {
+	typeName string
+	names    []string
+}
+
+// pkg is a type checked package.
+type pkg struct {
+	name  string
+	fset  *token.FileSet
+	types *types.Package
+}
+
+// loadPackage parses and type checks the non-test files of the package in dir.
+// Like the go command, files excluded by build constraints for the current platform are skipped.
+// Previously generated files are ignored and type errors are tolerated,
+// so that packages referring to not yet generated code can be loaded.
+func loadPackage(dir string) (*pkg, error) {
+	names, err := sourceFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	result := &pkg{fset: fset}
+
+	var files []*ast.File
+
+	for _, name := range names {
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.name != "" && result.name != file.Name.Name {
+			return nil, fmt.Errorf("found packages %s and %s in %s", result.name, file.Name.Name, dir)
+		}
+
+		result.name = file.Name.Name
+
+		if !isGenerated(file) {
+			files = append(files, file)
+		}
+	}
+
+	if result.name == "" {
+		return nil, fmt.Errorf("no go files found in %s", dir)
+	}
+
+	config := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(err error) {},
+	}
+
+	result.types, _ = config.Check(result.name, fset, files, nil)
+
+	return result, nil
+}
+
+// sourceFiles returns the names of the non-test go files in dir, which match the build constraints.
+func sourceFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		match, err := build.Default.MatchFile(dir, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if match {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+func isGenerated(file *ast.File) bool {
+	return len(file.Comments) > 0 && strings.HasPrefix(file.Comments[0].Text(), generatedHeader[3:])
+}
+
+// enum collects all constants of the named type in the order of their declaration.
+func (pkg *pkg) enum(typeName string) (*enum, error) {
+	object := pkg.types.Scope().Lookup(typeName)
+	if object == nil {
+		return nil, fmt.Errorf("type %s not found", typeName)
+	}
+
+	typeObject, ok := object.(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a type", typeName)
+	}
+
+	basic, ok := typeObject.Type().Underlying().(*types.Basic)
+	if !ok || basic.Info()&(types.IsInteger|types.IsString) == 0 {
+		return nil, fmt.Errorf("type %s must be an integer or string type", typeName)
+	}
+
+	constants := pkg.constants(typeObject.Type())
+	if len(constants) == 0 {
+		return nil, fmt.Errorf("no constants of type %s found", typeName)
+	}
+
+	result := &enum{typeName: typeName}
+	seen := map[string]bool{}
+
+	for _, object := range constants {
+		value := object.Val().ExactString()
+		if !seen[value] {
+			seen[value] = true
+			result.names = append(result.names, object.Name())
+		}
+	}
+
+	return result, nil
+}
+
+func (pkg *pkg) constants(typ types.Type) []*types.Const {
+	var constants []*types.Const
+
+	scope := pkg.types.Scope()
+
+	for _, name := range scope.Names() {
+		object, ok := scope.Lookup(name).(*types.Const)
+		if ok && types.Identical(object.Type(), typ) && object.Val().Kind() != constant.Unknown {
+			constants = append(constants, object)
+		}
+	}
+
+	sort.Slice(constants, func(i, j int) bool {
+		a, b := pkg.fset.Position(constants[i].Pos()), pkg.fset.Position(constants[j].Pos())
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+
+		return a.Offset < b.Offset
+	})
+
+	return constants
+}
+
+// snakeCase converts a type name like HTTPMethod to http_method.
+func snakeCase(name string) string {
+	var builder strings.Builder
+
+	runes := []rune(name)
+
+	for i, char := range runes {
+		boundary := i > 0 && unicode.IsUpper(char) &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])))
+		if boundary {
+			builder.WriteRune('_')
+		}
+
+		builder.WriteRune(unicode.ToLower(char))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,112 @@
+// Command cantor-gen generates compact sets for enum-like constant types.
+//
+// For a type like
+//
+//	type Role int
+//
+//	const (
+//		Admin Role = iota
+//		Editor
+//		Viewer
+//	)
+//
+// running
+//
+//	cantor-gen -type=Role
+//
+// in the package directory creates the file role_set.go containing:
+//
+//   - RoleSet, which implements cantor.Set[Role] using a bitmask with one bit per constant,
+//   - NewRoleSet, which returns a new RoleSet containing the given elements,
+//   - ParseRoleSet, which parses set literals like "{Admin, Viewer}" using the names of the constants,
+//   - RoleUniverse, a cantor.Universe[Role] containing all constants.
+//
+// RoleSet implements fmt.Stringer, json.Marshaler and json.Unmarshaler using the names of the constants.
+// Values of the type, which are not declared as constants, can not be added to the set.
+// Constants with equal values are treated as the same element, represented by the first declared name.
+//
+// The command is typically invoked using go generate:
+//
+//	//go:generate go run github.com/frederik-jatzkowski/cantor/cmd/cantor-gen -type=Role
+//
+// Usage:
+//
+//	cantor-gen -type=<type>[,<type>...] [-output <file>] [directory]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// exit is replaced during tests.
+var exit = os.Exit
+
+func main() {
+	exit(run(os.Args[1:], os.Stderr))
+}
+
+// run executes the command line given by args and returns the exit status.
+func run(args []string, stderr io.Writer) int {
+	flags := flag.NewFlagSet("cantor-gen", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	typeNames := flags.String("type", "", "comma-separated list of type names; required")
+	output := flags.String("output", "", "output file name; default <directory>/<type>_set.go")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return 2
+	}
+
+	if *typeNames == "" || flags.NArg() > 1 {
+		fmt.Fprintln(stderr, "usage: cantor-gen -type=<type>[,<type>...] [-output <file>] [directory]")
+
+		return 2
+	}
+
+	dir := "."
+	if flags.NArg() == 1 {
+		dir = flags.Arg(0)
+	}
+
+	err = generate(dir, strings.Split(*typeNames, ","), *output)
+	if err != nil {
+		fmt.Fprintf(stderr, "cantor-gen: %v\n", err)
+
+		return 1
+	}
+
+	return 0
+}
+
+// generate writes the generated code for all types of the package in dir to output.
+func generate(dir string, typeNames []string, output string) error {
+	pkg, err := loadPackage(dir)
+	if err != nil {
+		return err
+	}
+
+	enums := make([]*enum, 0, len(typeNames))
+
+	for _, typeName := range typeNames {
+		enum, err := pkg.enum(strings.TrimSpace(typeName))
+		if err != nil {
+			return err
+		}
+
+		enums = append(enums, enum)
+	}
+
+	source := render(pkg.name, typeNames, enums)
+
+	if output == "" {
+		output = filepath.Join(dir, snakeCase(enums[0].typeName)+"_set.go")
+	}
+
+	return os.WriteFile(output, source, 0o644) //nolint:gosec // generated code is not secret
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writePackage(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o700)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		err = os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	return dir
+}
+
+func TestRun_golden(t *testing.T) {
+	dir := filepath.Join("..", "..", "internal", "enums")
+	output := filepath.Join(t.TempDir(), "role_set.go")
+
+	var stderr bytes.Buffer
+
+	status := run([]string{"-type=Role,Level", "-output", output, dir}, &stderr)
+	if status != 0 {
+		t.Fatalf("expected status 0 but got %d: %s", status, stderr.String())
+	}
+
+	expected, err := os.ReadFile(filepath.Join(dir, "role_set.go"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("generated code differs from %s, run go generate ./internal/enums", filepath.Join(dir, "role_set.go"))
+	}
+}
+
+func TestRun_defaultOutput(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"color.go": `package colors
+
+type httpColor uint8
+
+const (
+	red httpColor = iota + 1
+	green
+	blue
+	unknown = httpColor(red)
+)
+`,
+		"more.go":       "package colors\n\nconst purple httpColor = 9\n",
+		"color_test.go": "package colors_test\n",
+		"old_set.go": "// Code generated by \"cantor-gen -type=httpColor\"; DO NOT EDIT.\n\n" +
+			"package colors\n\nvar x = undefined\n",
+		"ignored.go":     "//go:build ignore\n\npackage main\n\nconst orange httpColor = 10\n",
+		"color_plan9.go": "package colors\n\nconst black httpColor = 11\n",
+		"README.md":      "not go",
+		"sub/.gitignore": "",
+	})
+
+	var stderr bytes.Buffer
+
+	status := run([]string{"-type= httpColor", dir}, &stderr)
+	if status != 0 {
+		t.Fatalf("expected status 0 but got %d: %s", status, stderr.String())
+	}
+
+	source, err := os.ReadFile(filepath.Join(dir, "http_color_set.go"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, expected := range []string{
+		"type httpColorSet struct",
+		"func newHttpColorSet(",
+		"func parseHttpColorSet(",
+		`var httpColorNames = [...]string{"red", "green", "blue", "purple"}`,
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Errorf("expected generated code to contain %q", expected)
+		}
+	}
+}
+
+func TestRun_errors(t *testing.T) {
+	valid := writePackage(t, map[string]string{
+		"a.go": `package a
+
+type Struct struct{}
+
+type Float float64
+
+type Empty int
+
+type Int int
+
+const One Int = 1
+
+var Variable = 1
+`,
+	})
+
+	unreadable := writePackage(t, nil)
+
+	err := os.Symlink(filepath.Join(unreadable, "missing"), filepath.Join(unreadable, "a.go"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		args     []string
+		status   int
+		expected string
+	}{
+		"missing type":  {args: []string{valid}, status: 2, expected: "usage"},
+		"too many args": {args: []string{"-type=Int", valid, valid}, status: 2, expected: "usage"},
+		"unknown flag":  {args: []string{"-unknown"}, status: 2, expected: "flag provided but not defined"},
+		"missing dir":   {args: []string{"-type=Int", filepath.Join(valid, "missing")}, status: 1, expected: "no such file"},
+		"unknown type":  {args: []string{"-type=Missing", valid}, status: 1, expected: "type Missing not found"},
+		"not a type":    {args: []string{"-type=Variable", valid}, status: 1, expected: "Variable is not a type"},
+		"struct":        {args: []string{"-type=Struct", valid}, status: 1, expected: "must be an integer or string type"},
+		"float":         {args: []string{"-type=Float", valid}, status: 1, expected: "must be an integer or string type"},
+		"no constants":  {args: []string{"-type=Empty", valid}, status: 1, expected: "no constants of type Empty found"},
+		"unwritable":    {args: []string{"-type=Int", "-output", valid, valid}, status: 1, expected: "is a directory"},
+		"no go files":   {args: []string{"-type=Int", writePackage(t, nil)}, status: 1, expected: "no go files"},
+		"unreadable":    {args: []string{"-type=Int", unreadable}, status: 1, expected: "no such file"},
+		"syntax error": {
+			args:     []string{"-type=Int", writePackage(t, map[string]string{"a.go": "package"})},
+			status:   1,
+			expected: "expected 'IDENT'",
+		},
+		"mixed packages": {
+			args:     []string{"-type=Int", writePackage(t, map[string]string{"a.go": "package a", "b.go": "package b"})},
+			status:   1,
+			expected: "found packages a and b",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			var stderr bytes.Buffer
+
+			status := run(test.args, &stderr)
+			if status != test.status || !strings.Contains(stderr.String(), test.expected) {
+				t.Errorf("expected status %d and %q but got %d and %q", test.status, test.expected, status, stderr.String())
+			}
+		})
+	}
+}
+
+func TestRender_invalidSource(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic for an invalid package name")
+		}
+	}()
+
+	render("1invalid", []string{"Int"}, []*enum{{typeName: "Int", names: []string{"One"}}})
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"Role":       "role",
+		"HTTPMethod": "http_method",
+		"userID":     "user_id",
+		"A":          "a",
+		"Ümlaut":     "ümlaut",
+	}
+
+	for input, expected := range tests {
+		actual := snakeCase(input)
+		if actual != expected {
+			t.Errorf("expected %s for %s but got %s", expected, input, actual)
+		}
+	}
+}
+
+func TestMain_exit(t *testing.T) {
+	defer func(args []string) {
+		os.Args = args
+		exit = os.Exit
+	}(os.Args)
+
+	status := -1
+	exit = func(code int) {
+		status = code
+	}
+	os.Args = []string{"cantor-gen"}
+
+	defer func(file *os.File) { os.Stderr = file }(os.Stderr)
+
+	file, err := os.Create(filepath.Join(t.TempDir(), "stderr"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	defer file.Close()
+
+	os.Stderr = file
+
+	main()
+
+	if status != 2 {
+		t.Errorf("expected exit status 2 but got %d", status)
+	}
+}
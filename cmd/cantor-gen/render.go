@@ -0,0 +1,440 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// templateData holds the names used in the generated code for a single enum.
+type templateData struct {
+	Type     string
+	Set      string
+	New      string
+	Parse    string
+	Universe string
+	Index    string
+	ByName   string
+	Names    string
+	Values   string
+	Words    string
+	Elements []string
+}
+
+func newTemplateData(enum *enum) templateData {
+	exported := ast.IsExported(enum.typeName)
+	lower := lowerFirst(enum.typeName)
+	upper := upperFirst(enum.typeName)
+
+	data := templateData{
+		Type:     enum.typeName,
+		Set:      enum.typeName + "Set",
+		New:      "New" + upper + "Set",
+		Parse:    "Parse" + upper + "Set",
+		Universe: enum.typeName + "Universe",
+		Index:    lower + "Index",
+		ByName:   lower + "IndexByName",
+		Names:    lower + "Names",
+		Values:   lower + "Values",
+		Words:    lower + "SetWords",
+		Elements: enum.names,
+	}
+
+	if !exported {
+		data.New = "new" + upper + "Set"
+		data.Parse = "parse" + upper + "Set"
+	}
+
+	return data
+}
+
+// render returns the formatted source code for all enums.
+// It panics if the generated code is invalid, which indicates a bug in the templates.
+func render(packageName string, typeNames []string, enums []*enum) []byte {
+	var buffer bytes.Buffer
+
+	// executing the templates can only fail when writing to the buffer, which does not return errors
+	_ = fileTemplate.Execute(&buffer, map[string]interface{}{
+		"Package": packageName,
+		"Types":   strings.Join(typeNames, ","),
+	})
+
+	for _, enum := range enums {
+		_ = setTemplate.Execute(&buffer, newTemplateData(enum))
+	}
+
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		panic(fmt.Sprintf("cantor-gen: generated invalid code: %v", err))
+	}
+
+	return source
+}
+
+func lowerFirst(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+
+	return string(runes)
+}
+
+func upperFirst(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+
+	return string(runes)
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(
+	`// Code generated by "cantor-gen -type={{.Types}}"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+`))
+
+var setTemplate = template.Must(template.New("set").Parse(`
+const {{.Words}} = ({{len .Elements}} + 63) / 64
+
+// {{.Set}} implements [cantor.Set] for {{.Type}} using a bitmask with one bit for each constant of type {{.Type}}.
+// Values of {{.Type}}, which are not declared as constants, can not be added.
+//
+// The zero value is an empty set. {{.Set}} must be used by pointer.
+type {{.Set}} struct {
+	bits [{{.Words}}]uint64
+}
+
+// {{.Universe}} contains all constants of type {{.Type}}.
+var {{.Universe}} = cantor.NewUniverse({{.Values}}[:]...)
+
+var {{.Names}} = [...]string{ {{- range .Elements}}"{{.}}", {{end -}} }
+
+var {{.Values}} = [...]{{.Type}}{ {{- range .Elements}}{{.}}, {{end -}} }
+
+func {{.Index}}(element {{.Type}}) (int, bool) {
+	switch element {
+	{{- range $index, $element := .Elements}}
+	case {{$element}}:
+		return {{$index}}, true
+	{{- end}}
+	default:
+		return 0, false
+	}
+}
+
+func {{.ByName}}(name string) (int, error) {
+	for i, candidate := range {{.Names}} {
+		if candidate == name {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown {{.Type}} %q", name)
+}
+
+// {{.New}} returns a new {{.Set}} containing all given elements.
+// Elements, which are not declared as constants of type {{.Type}}, are ignored.
+func {{.New}}(elements ...{{.Type}}) *{{.Set}} {
+	set := &{{.Set}}{}
+
+	for _, element := range elements {
+		set.Add(element)
+	}
+
+	return set
+}
+
+// {{.Parse}} parses a set literal like "{ {{- index .Elements 0 -}} }"
+// using the names of the constants of type {{.Type}}.
+func {{.Parse}}(s string) (*{{.Set}}, error) {
+	indices, err := cantor.ParseHashSet(s, {{.ByName}})
+	if err != nil {
+		return nil, err
+	}
+
+	set := &{{.Set}}{}
+
+	for index := range indices {
+		set.bits[index/64] |= 1 << (index % 64)
+	}
+
+	return set, nil
+}
+
+// Add adds element and returns true if this operation actually changed the set.
+// If the element was already contained or is not declared as constant of type {{.Type}},
+// this leaves the set unchanged and returns false.
+//
+// The time complexity of this method is O(1).
+func (set *{{.Set}}) Add(element {{.Type}}) (modified bool) {
+	index, ok := {{.Index}}(element)
+	if !ok || set.bits[index/64]&(1<<(index%64)) != 0 {
+		return false
+	}
+
+	set.bits[index/64] |= 1 << (index % 64)
+
+	return true
+}
+
+// Remove removes element and returns true if this operation actually changed the set.
+//
+// The time complexity of this method is O(1).
+func (set *{{.Set}}) Remove(element {{.Type}}) (modified bool) {
+	index, ok := {{.Index}}(element)
+	if !ok || set.bits[index/64]&(1<<(index%64)) == 0 {
+		return false
+	}
+
+	set.bits[index/64] &^= 1 << (index % 64)
+
+	return true
+}
+
+// AddAll adds all elements of the iterator and returns the number of elements, which were actually added.
+//
+// The time complexity of this method is O(n), where n is the number of elements of the iterator.
+func (set *{{.Set}}) AddAll(iterator cantor.Iterator[{{.Type}}]) (modified int) {
+	iterator(func(element {{.Type}}) bool {
+		if set.Add(element) {
+			modified++
+		}
+
+		return true
+	})
+
+	return modified
+}
+
+// RemoveAll removes all elements, which are contained in the other container,
+// and returns the number of elements, which were actually removed.
+// If the other container is a *{{.Set}}, this is computed word by word.
+func (set *{{.Set}}) RemoveAll(other cantor.Container[{{.Type}}]) (modified int) {
+	before := set.Size()
+
+	if bitmask, ok := other.(*{{.Set}}); ok {
+		for i := range set.bits {
+			set.bits[i] &^= bitmask.bits[i]
+		}
+	} else {
+		set.filter(func(element {{.Type}}) bool {
+			return !other.Contains(element)
+		})
+	}
+
+	return before - set.Size()
+}
+
+// RetainAll removes all elements, which are not contained in the other container,
+// and returns the number of elements, which were actually removed.
+// If the other container is a *{{.Set}}, this is computed word by word.
+func (set *{{.Set}}) RetainAll(other cantor.Container[{{.Type}}]) (modified int) {
+	before := set.Size()
+
+	if bitmask, ok := other.(*{{.Set}}); ok {
+		for i := range set.bits {
+			set.bits[i] &= bitmask.bits[i]
+		}
+	} else {
+		set.filter(other.Contains)
+	}
+
+	return before - set.Size()
+}
+
+// Clear removes all elements and returns the number of elements, which were actually removed.
+//
+// The time complexity of this method is O(1).
+func (set *{{.Set}}) Clear() (modified int) {
+	modified = set.Size()
+	set.bits = [{{.Words}}]uint64{}
+
+	return modified
+}
+
+// filter removes all elements, which should not be kept.
+func (set *{{.Set}}) filter(keep func(element {{.Type}}) bool) {
+	for i := range set.bits {
+		for word := set.bits[i]; word != 0; word &= word - 1 {
+			if !keep({{.Values}}[i*64+bits.TrailingZeros64(word)]) {
+				set.bits[i] &^= word & -word
+			}
+		}
+	}
+}
+
+// Contains returns whether the element is contained in this set.
+//
+// The time complexity of this method is O(1).
+func (set *{{.Set}}) Contains(element {{.Type}}) bool {
+	index, ok := {{.Index}}(element)
+
+	return ok && set.bits[index/64]&(1<<(index%64)) != 0
+}
+
+// Union returns a [cantor.ReadableSet] representing the set union of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *{{.Set}}) Union(other cantor.ReadableSet[{{.Type}}]) cantor.ReadableSet[{{.Type}}] {
+	return cantor.NewUnion[{{.Type}}](set, other)
+}
+
+// Intersect returns a [cantor.ReadableSet] representing the set intersection of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *{{.Set}}) Intersect(other cantor.Container[{{.Type}}]) cantor.ReadableSet[{{.Type}}] {
+	return cantor.NewIntersection[{{.Type}}](set, other)
+}
+
+// Complement returns a [cantor.ImplicitSet], representing all elements not contained in this set.
+// Use {{.Universe}}.ComplementOf for an enumerable complement.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *{{.Set}}) Complement() cantor.ImplicitSet[{{.Type}}] {
+	return cantor.NewImplicitSet(func(element {{.Type}}) bool {
+		return !set.Contains(element)
+	})
+}
+
+// Difference returns a [cantor.ReadableSet] with all elements of this set, which are not contained in the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *{{.Set}}) Difference(other cantor.Container[{{.Type}}]) cantor.ReadableSet[{{.Type}}] {
+	return cantor.NewDifference[{{.Type}}](set, other)
+}
+
+// SymmetricDifference returns a [cantor.ReadableSet] representing the set with all elements of this
+// and the other set, which are contained in exactly one of the two.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *{{.Set}}) SymmetricDifference(other cantor.ReadableSet[{{.Type}}]) cantor.ReadableSet[{{.Type}}] {
+	return set.Difference(other).Union(other.Difference(set))
+}
+
+// Equals returns true, if this set and the other set represent exactly the same elements.
+func (set *{{.Set}}) Equals(other cantor.ReadableSet[{{.Type}}]) bool {
+	if other, ok := other.(*{{.Set}}); ok {
+		return set.bits == other.bits
+	}
+
+	return set.SymmetricDifference(other).Size() == 0
+}
+
+// Subset returns true, if all elements of this set are contained in the other container.
+func (set *{{.Set}}) Subset(other cantor.Container[{{.Type}}]) bool {
+	if other, ok := other.(*{{.Set}}); ok {
+		for i := range set.bits {
+			if set.bits[i]&^other.bits[i] != 0 {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return set.Difference(other).Size() == 0
+}
+
+// StrictSubset returns true, if all elements of this set are contained in the other set
+// and the sets are not equal.
+func (set *{{.Set}}) StrictSubset(other cantor.ReadableSet[{{.Type}}]) bool {
+	return set.Subset(other) && !set.Equals(other)
+}
+
+// Elements returns a [cantor.Iterator] over the elements of this set in the order of their declaration.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *{{.Set}}) Elements() cantor.Iterator[{{.Type}}] {
+	return func(yield func(element {{.Type}}) (next bool)) {
+		for i := range set.bits {
+			for word := set.bits[i]; word != 0; word &= word - 1 {
+				if !yield({{.Values}}[i*64+bits.TrailingZeros64(word)]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Size returns the number of elements in this set.
+//
+// The time complexity of this method is O(1).
+func (set *{{.Set}}) Size() int {
+	size := 0
+	for _, word := range set.bits {
+		size += bits.OnesCount64(word)
+	}
+
+	return size
+}
+
+// Bits implements [cantor.Bitmask], which allows computing similarities word by word.
+func (set *{{.Set}}) Bits() []uint64 {
+	return set.bits[:]
+}
+
+// String implements [fmt.Stringer] using the names of the constants, for example "{ {{- index .Elements 0 -}} }".
+func (set *{{.Set}}) String() string {
+	return "{" + strings.Join(set.names(), ", ") + "}"
+}
+
+// MarshalJSON implements [json.Marshaler] and encodes the set as array of the names of its elements.
+func (set *{{.Set}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(set.names())
+}
+
+// UnmarshalJSON implements [json.Unmarshaler] and decodes an array of names into the set.
+// The previous contents of the set are replaced.
+func (set *{{.Set}}) UnmarshalJSON(data []byte) error {
+	var names []string
+
+	err := json.Unmarshal(data, &names)
+	if err != nil {
+		return err
+	}
+
+	parsed := {{.Set}}{}
+
+	for _, name := range names {
+		index, err := {{.ByName}}(name)
+		if err != nil {
+			return err
+		}
+
+		parsed.bits[index/64] |= 1 << (index % 64)
+	}
+
+	*set = parsed
+
+	return nil
+}
+
+// SafeForConcurrentReads implements [cantor.ConcurrentReader] and returns true,
+// since the set can be read concurrently as long as it is not modified at the same time.
+func (set *{{.Set}}) SafeForConcurrentReads() bool {
+	return true
+}
+
+func (set *{{.Set}}) names() []string {
+	names := []string{}
+
+	for i := range set.bits {
+		for word := set.bits[i]; word != 0; word &= word - 1 {
+			names = append(names, {{.Names}}[i*64+bits.TrailingZeros64(word)])
+		}
+	}
+
+	return names
+}
+`))
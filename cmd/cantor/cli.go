@@ -0,0 +1,333 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/iterators"
+	"github.com/frederik-jatzkowski/cantor/query"
+)
+
+const (
+	statusTrue  = 0
+	statusFalse = 1
+	statusError = 2
+)
+
+const usage = `usage: cantor <command> [flags] <inputs...>
+
+commands:
+  union      print all elements contained in any input
+  intersect  print all elements contained in every input
+  diff       print all elements of the first input, which are not contained in any other input
+  symdiff    print all elements contained in an odd number of inputs
+  subset     exit with status 0 if the first input is a subset of the second one, 1 otherwise
+  equals     exit with status 0 if both inputs contain the same elements, 1 otherwise
+  count      print the number of unique elements in all inputs
+  eval       print the result of an expression, e.g. cantor eval '(a | b) - c' a=a.txt b=b.txt c=c.txt
+`
+
+var errUsage = errors.New("invalid usage")
+
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	input  inputFormat
+	output string
+	sorted bool
+}
+
+type command struct {
+	minArgs int
+	maxArgs int
+	run     func(cli *cli, sets []cantor.HashSet[string]) (int, error)
+}
+
+var commands = map[string]command{
+	"union":     {minArgs: 1, run: (*cli).union},
+	"intersect": {minArgs: 1, run: (*cli).intersect},
+	"diff":      {minArgs: 1, run: (*cli).diff},
+	"symdiff":   {minArgs: 1, run: (*cli).symdiff},
+	"subset":    {minArgs: 2, maxArgs: 2, run: (*cli).subset},
+	"equals":    {minArgs: 2, maxArgs: 2, run: (*cli).equals},
+	"count":     {minArgs: 1, run: (*cli).count},
+}
+
+func (cli *cli) run(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(cli.stderr, usage)
+
+		return statusError
+	}
+
+	status, err := cli.dispatch(args[0], args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return statusTrue
+	}
+
+	if errors.Is(err, errUsage) {
+		fmt.Fprintf(cli.stderr, "cantor: %v\n\n%s", err, usage)
+
+		return statusError
+	}
+
+	if err != nil {
+		fmt.Fprintf(cli.stderr, "cantor: %v\n", err)
+
+		return statusError
+	}
+
+	return status
+}
+
+func (cli *cli) dispatch(name string, args []string) (int, error) {
+	if isHelp(name) {
+		fmt.Fprint(cli.stdout, usage)
+
+		return statusTrue, nil
+	}
+
+	flags, err := cli.parseFlags(name, args)
+	if err != nil {
+		return statusError, err
+	}
+
+	if name == "eval" {
+		return cli.eval(flags.Args())
+	}
+
+	command, err := lookupCommand(name, flags.NArg())
+	if err != nil {
+		return statusError, err
+	}
+
+	sets, err := cli.readAll(flags.Args())
+	if err != nil {
+		return statusError, err
+	}
+
+	return command.run(cli, sets)
+}
+
+func isHelp(name string) bool {
+	return name == "-h" || name == "-help" || name == "help"
+}
+
+func (cli *cli) parseFlags(name string, args []string) (*flag.FlagSet, error) {
+	flags := cli.flags(name)
+
+	err := flags.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if cli.output != "lines" && cli.output != "json" {
+		return nil, fmt.Errorf("%w: unknown output format %q", errUsage, cli.output)
+	}
+
+	return flags, nil
+}
+
+// lookupCommand returns the command with the given name, if it accepts the number of inputs.
+func lookupCommand(name string, inputs int) (command, error) {
+	command, ok := commands[name]
+	if !ok {
+		return command, fmt.Errorf("%w: unknown command %q", errUsage, name)
+	}
+
+	if inputs < command.minArgs || (command.maxArgs > 0 && inputs > command.maxArgs) {
+		return command, fmt.Errorf("%w: wrong number of inputs for %s", errUsage, name)
+	}
+
+	return command, nil
+}
+
+func (cli *cli) flags(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet("cantor "+name, flag.ContinueOnError)
+	flags.SetOutput(cli.stderr)
+
+	flags.Var(&cli.input, "format", "input format: lines, csv or json")
+	flags.IntVar(&cli.input.column, "column", 1, "column of csv inputs holding the elements, starting at 1")
+	flags.BoolVar(&cli.input.header, "header", false, "skip the first row of csv inputs")
+	flags.StringVar(&cli.output, "output", "lines", "output format: lines or json")
+	flags.BoolVar(&cli.sorted, "sort", false, "sort the output")
+
+	return flags
+}
+
+func (cli *cli) readAll(paths []string) ([]cantor.HashSet[string], error) {
+	sets := make([]cantor.HashSet[string], 0, len(paths))
+
+	for _, path := range paths {
+		set, err := cli.input.read(path, cli.stdin)
+		if err != nil {
+			return nil, err
+		}
+
+		sets = append(sets, set)
+	}
+
+	return sets, nil
+}
+
+func (cli *cli) union(sets []cantor.HashSet[string]) (int, error) {
+	var result cantor.ReadableSet[string] = sets[0]
+
+	for _, set := range sets[1:] {
+		result = result.Union(set)
+	}
+
+	return statusTrue, cli.print(result)
+}
+
+func (cli *cli) intersect(sets []cantor.HashSet[string]) (int, error) {
+	var result cantor.ReadableSet[string] = sets[0]
+
+	for _, set := range sets[1:] {
+		result = result.Intersect(set)
+	}
+
+	return statusTrue, cli.print(result)
+}
+
+func (cli *cli) diff(sets []cantor.HashSet[string]) (int, error) {
+	var result cantor.ReadableSet[string] = sets[0]
+
+	for _, set := range sets[1:] {
+		result = result.Difference(set)
+	}
+
+	return statusTrue, cli.print(result)
+}
+
+func (cli *cli) symdiff(sets []cantor.HashSet[string]) (int, error) {
+	var result cantor.ReadableSet[string] = sets[0]
+
+	for _, set := range sets[1:] {
+		result = result.SymmetricDifference(set)
+	}
+
+	return statusTrue, cli.print(result)
+}
+
+func (cli *cli) subset(sets []cantor.HashSet[string]) (int, error) {
+	return cli.printBool(sets[0].Subset(sets[1]))
+}
+
+func (cli *cli) equals(sets []cantor.HashSet[string]) (int, error) {
+	return cli.printBool(sets[0].Equals(sets[1]))
+}
+
+func (cli *cli) count(sets []cantor.HashSet[string]) (int, error) {
+	result := sets[0].Size()
+	if len(sets) > 1 {
+		union := sets[0].Union(sets[1])
+
+		for _, set := range sets[2:] {
+			union = union.Union(set)
+		}
+
+		result = union.Size()
+	}
+
+	_, err := fmt.Fprintln(cli.stdout, result)
+
+	return statusTrue, err
+}
+
+func (cli *cli) eval(args []string) (int, error) {
+	if len(args) == 0 {
+		return statusError, fmt.Errorf("%w: missing expression", errUsage)
+	}
+
+	env := query.Env[string]{}
+
+	for _, arg := range args[1:] {
+		name, path, ok := strings.Cut(arg, "=")
+		if !ok || name == "" {
+			return statusError, fmt.Errorf("%w: expected name=path but got %q", errUsage, arg)
+		}
+
+		set, err := cli.input.read(path, cli.stdin)
+		if err != nil {
+			return statusError, err
+		}
+
+		env[name] = set
+	}
+
+	expr, err := query.Parse(args[0])
+	if err != nil {
+		return statusError, err
+	}
+
+	result, err := env.EvaluateReadable(expr)
+	if err != nil {
+		return statusError, err
+	}
+
+	return statusTrue, cli.print(result)
+}
+
+func (cli *cli) printBool(result bool) (int, error) {
+	_, err := fmt.Fprintln(cli.stdout, result)
+	if !result {
+		return statusFalse, err
+	}
+
+	return statusTrue, err
+}
+
+func (cli *cli) print(set cantor.ReadableSet[string]) error {
+	if cli.output == "json" {
+		return cli.printJSON(set)
+	}
+
+	elements := set.Elements()
+	if cli.sorted {
+		elements = iterators.Sorted(elements, strings.Compare)
+	}
+
+	writer := bufio.NewWriter(cli.stdout)
+
+	var err error
+
+	elements(func(element string) (next bool) {
+		_, err = fmt.Fprintln(writer, element)
+
+		return err == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+func (cli *cli) printJSON(set cantor.ReadableSet[string]) error {
+	var (
+		data []byte
+		err  error
+	)
+
+	// the set is only evaluated once, either sorted or in no particular order
+	if cli.sorted {
+		data, err = cantor.MarshalJSONSorted(set, strings.Compare)
+	} else {
+		data, err = json.Marshal(set)
+	}
+
+	if err == nil {
+		_, err = fmt.Fprintln(cli.stdout, string(data))
+	}
+
+	return err
+}
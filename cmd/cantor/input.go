@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+// inputFormat describes how inputs are read. It implements [flag.Value] for the name of the format.
+type inputFormat struct {
+	name   string
+	column int
+	header bool
+}
+
+func (format *inputFormat) String() string {
+	if format.name == "" {
+		return "lines"
+	}
+
+	return format.name
+}
+
+func (format *inputFormat) Set(name string) error {
+	switch name {
+	case "lines", "csv", "json":
+		format.name = name
+
+		return nil
+	default:
+		return fmt.Errorf("unknown input format %q", name)
+	}
+}
+
+// read reads the input at path into a new set. The path "-" refers to stdin.
+func (format *inputFormat) read(path string, stdin io.Reader) (cantor.HashSet[string], error) {
+	reader := stdin
+
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		defer file.Close()
+
+		reader = file
+	}
+
+	set := cantor.NewHashSet[string]()
+
+	var err error
+
+	switch format.String() {
+	case "csv":
+		err = format.readCSV(reader, set)
+	case "json":
+		err = readJSON(reader, set)
+	default:
+		err = readLines(reader, set)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return set, nil
+}
+
+// readLines adds each non-empty line to set.
+func readLines(reader io.Reader, set cantor.HashSet[string]) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(nil, 1<<20)
+
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line != "" {
+			set.Add(line)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// readCSV adds the configured column of each record to set.
+func (format *inputFormat) readCSV(reader io.Reader, set cantor.HashSet[string]) error {
+	if format.column < 1 {
+		return fmt.Errorf("invalid column %d", format.column)
+	}
+
+	records := csv.NewReader(reader)
+	records.FieldsPerRecord = -1
+
+	for skip := format.header; ; skip = false {
+		record, err := records.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if skip {
+			continue
+		}
+
+		if len(record) < format.column {
+			line, _ := records.FieldPos(0)
+
+			return fmt.Errorf("line %d: missing column %d", line, format.column)
+		}
+
+		set.Add(record[format.column-1])
+	}
+}
+
+// readJSON adds the elements of a JSON array to set.
+// Strings are added as they are, all other values using their compact JSON encoding.
+func readJSON(reader io.Reader, set cantor.HashSet[string]) error {
+	var elements []json.RawMessage
+
+	err := json.NewDecoder(reader).Decode(&elements)
+	if err != nil {
+		return err
+	}
+
+	for _, element := range elements {
+		var text string
+
+		if element[0] == '"' {
+			_ = json.Unmarshal(element, &text)
+		} else {
+			var compact bytes.Buffer
+
+			_ = json.Compact(&compact, element)
+			text = compact.String()
+		}
+
+		set.Add(text)
+	}
+
+	return nil
+}
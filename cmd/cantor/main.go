@@ -0,0 +1,41 @@
+// Command cantor performs set operations on files.
+//
+// Usage:
+//
+//	cantor <command> [flags] <inputs...>
+//
+// The commands are:
+//
+//	union      print all elements contained in any input
+//	intersect  print all elements contained in every input
+//	diff       print all elements of the first input, which are not contained in any other input
+//	symdiff    print all elements contained in an odd number of inputs
+//	subset     exit with status 0 if the first input is a subset of the second one, 1 otherwise
+//	equals     exit with status 0 if both inputs contain the same elements, 1 otherwise
+//	count      print the number of unique elements in all inputs
+//	eval       print the result of a set expression over named inputs, for example:
+//	           cantor eval '(a | b) - c' a=a.txt b=b.txt c=c.txt
+//
+// Inputs are files or "-" for standard input. They are read as newline-delimited elements by default.
+// Results are streamed to standard output, one element per line.
+// Run "cantor <command> -h" for the available flags.
+package main
+
+import (
+	"io"
+	"os"
+)
+
+// exit is replaced during tests.
+var exit = os.Exit
+
+func main() {
+	exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command line given by args and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	cli := &cli{stdin: stdin, stdout: stdout, stderr: stderr}
+
+	return cli.run(args)
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+
+	err := os.WriteFile(path, []byte(content), 0o600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return path
+}
+
+type result struct {
+	status int
+	stdout string
+	stderr string
+}
+
+func execute(stdin string, args ...string) result {
+	var stdout, stderr bytes.Buffer
+
+	status := run(args, strings.NewReader(stdin), &stdout, &stderr)
+
+	return result{status: status, stdout: stdout.String(), stderr: stderr.String()}
+}
+
+func TestRun(t *testing.T) {
+	a := writeFile(t, "a.txt", "a\nb\r\nc\n\nc\n")
+	b := writeFile(t, "b.txt", "b\nd\n")
+	c := writeFile(t, "c.txt", "c\nd\ne\n")
+	csv := writeFile(t, "a.csv", "id,name\n1,a\n2,b\n3,\"x,y\"\n")
+	json := writeFile(t, "a.json", `["a", 1, 2.50, {"b": [ 1 ]}, null]`)
+
+	tests := map[string]struct {
+		stdin    string
+		args     []string
+		expected result
+	}{
+		"union":            {args: []string{"union", "-sort", a, b, c}, expected: result{stdout: "a\nb\nc\nd\ne\n"}},
+		"union of one":     {args: []string{"union", "-sort", b}, expected: result{stdout: "b\nd\n"}},
+		"intersect":        {args: []string{"intersect", a, b}, expected: result{stdout: "b\n"}},
+		"diff":             {args: []string{"diff", "-sort", a, b, c}, expected: result{stdout: "a\n"}},
+		"symdiff":          {args: []string{"symdiff", "-sort", a, b, c}, expected: result{stdout: "a\ne\n"}},
+		"subset":           {args: []string{"subset", b, c}, expected: result{status: 1, stdout: "false\n"}},
+		"subset of itself": {args: []string{"subset", b, b}, expected: result{stdout: "true\n"}},
+		"equals":           {args: []string{"equals", a, a}, expected: result{stdout: "true\n"}},
+		"not equals":       {args: []string{"equals", a, b}, expected: result{status: 1, stdout: "false\n"}},
+		"count":            {args: []string{"count", a}, expected: result{stdout: "3\n"}},
+		"count union":      {args: []string{"count", a, b, c}, expected: result{stdout: "5\n"}},
+		"stdin":            {stdin: "b\nx\n", args: []string{"intersect", "-", b}, expected: result{stdout: "b\n"}},
+		"json output":      {args: []string{"intersect", "-output", "json", a, b}, expected: result{stdout: "[\"b\"]\n"}},
+		"sorted json output": {
+			args:     []string{"union", "-output", "json", "-sort", b, a},
+			expected: result{stdout: "[\"a\",\"b\",\"c\",\"d\"]\n"},
+		},
+		"csv": {
+			args:     []string{"union", "-format", "csv", "-column", "2", "-header", "-sort", csv},
+			expected: result{stdout: "a\nb\nx,y\n"},
+		},
+		"csv without header": {
+			args:     []string{"count", "-format", "csv", csv},
+			expected: result{stdout: "4\n"},
+		},
+		"json": {
+			args:     []string{"union", "-format", "json", "-sort", json},
+			expected: result{stdout: "1\n2.50\na\nnull\n{\"b\":[1]}\n"},
+		},
+		"eval": {
+			args:     []string{"eval", "-sort", "(a | b) - c", "a=" + a, "b=" + b, "c=" + c},
+			expected: result{stdout: "a\nb\n"},
+		},
+		"eval from stdin": {
+			stdin:    "x\n",
+			args:     []string{"eval", "a ^ b", "a=-", "b=" + writeFile(t, "x.txt", "x\n")},
+			expected: result{},
+		},
+		"help":         {args: []string{"help"}, expected: result{stdout: usage}},
+		"command help": {args: []string{"union", "-h"}, expected: result{stderr: "*"}},
+		"no arguments": {args: []string{}, expected: result{status: 2, stderr: usage}},
+		"unknown command": {
+			args:     []string{"merge", a},
+			expected: result{status: 2, stderr: "cantor: invalid usage: unknown command \"merge\"\n\n" + usage},
+		},
+		"missing inputs": {
+			args:     []string{"union"},
+			expected: result{status: 2, stderr: "cantor: invalid usage: wrong number of inputs for union\n\n" + usage},
+		},
+		"too many inputs": {
+			args:     []string{"subset", a, b, c},
+			expected: result{status: 2, stderr: "cantor: invalid usage: wrong number of inputs for subset\n\n" + usage},
+		},
+		"unknown flag": {args: []string{"union", "-x", a}, expected: result{status: 2, stderr: "*"}},
+		"unknown input format": {
+			args:     []string{"union", "-format", "xml", a},
+			expected: result{status: 2, stderr: "*"},
+		},
+		"unknown output format": {
+			args:     []string{"union", "-output", "xml", a},
+			expected: result{status: 2, stderr: "cantor: invalid usage: unknown output format \"xml\"\n\n" + usage},
+		},
+		"missing file": {
+			args:     []string{"union", filepath.Join(t.TempDir(), "missing.txt")},
+			expected: result{status: 2, stderr: "*"},
+		},
+		"invalid column": {
+			args:     []string{"union", "-format", "csv", "-column", "0", csv},
+			expected: result{status: 2, stderr: "cantor: " + csv + ": invalid column 0\n"},
+		},
+		"missing column": {
+			args:     []string{"union", "-format", "csv", "-column", "3", csv},
+			expected: result{status: 2, stderr: "cantor: " + csv + ": line 1: missing column 3\n"},
+		},
+		"invalid csv": {
+			stdin:    "\"a\n",
+			args:     []string{"union", "-format", "csv", "-"},
+			expected: result{status: 2, stderr: "*"},
+		},
+		"invalid json": {
+			stdin:    "{}",
+			args:     []string{"union", "-format", "json", "-"},
+			expected: result{status: 2, stderr: "*"},
+		},
+		"eval without expression": {
+			args:     []string{"eval"},
+			expected: result{status: 2, stderr: "cantor: invalid usage: missing expression\n\n" + usage},
+		},
+		"eval with invalid input": {
+			args:     []string{"eval", "a", "a"},
+			expected: result{status: 2, stderr: "cantor: invalid usage: expected name=path but got \"a\"\n\n" + usage},
+		},
+		"eval with missing file": {
+			args:     []string{"eval", "a", "a=" + filepath.Join(t.TempDir(), "missing.txt")},
+			expected: result{status: 2, stderr: "*"},
+		},
+		"eval with syntax error": {
+			args: []string{"eval", "a |", "a=" + a},
+			expected: result{
+				status: 2,
+				stderr: "cantor: query: error at offset 3: expected identifier, '~' or '(', got end of input\n",
+			},
+		},
+		"eval of complement": {
+			args:     []string{"eval", "~a", "a=" + a},
+			expected: result{status: 2, stderr: "cantor: query: expression is not enumerable: ~a\n"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			actual := execute(test.stdin, test.args...)
+
+			if actual.status != test.expected.status || actual.stdout != test.expected.stdout {
+				t.Errorf("expected status %d and output %q but got %d and %q (%s)",
+					test.expected.status, test.expected.stdout, actual.status, actual.stdout, actual.stderr)
+			}
+
+			if test.expected.stderr == "*" && actual.stderr == "" {
+				t.Error("expected an error message")
+			}
+
+			if test.expected.stderr != "*" && actual.stderr != test.expected.stderr {
+				t.Errorf("expected error message %q but got %q", test.expected.stderr, actual.stderr)
+			}
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func TestRun_failingOutput(t *testing.T) {
+	var large strings.Builder
+	for i := 0; i < 10000; i++ {
+		large.WriteString(strings.Repeat("x", i%100) + "\n")
+	}
+
+	a := writeFile(t, "a.txt", "a\n")
+	tests := map[string][]string{
+		"lines":       {"union", a},
+		"large lines": {"union", writeFile(t, "large.txt", large.String())},
+		"json":        {"union", "-output", "json", a},
+		"count":       {"count", a},
+	}
+
+	for name, args := range tests {
+		args := args
+
+		t.Run(name, func(t *testing.T) {
+			var stderr bytes.Buffer
+
+			status := run(args, strings.NewReader(""), failingWriter{}, &stderr)
+			if status != statusError || !strings.Contains(stderr.String(), io.ErrClosedPipe.Error()) {
+				t.Errorf("expected write error but got status %d and %q", status, stderr.String())
+			}
+		})
+	}
+}
+
+func TestMain_exit(t *testing.T) {
+	defer func(args []string, stdout *os.File) {
+		os.Args = args
+		os.Stdout = stdout
+		exit = os.Exit
+	}(os.Args, os.Stdout)
+
+	stdout, err := os.Create(filepath.Join(t.TempDir(), "stdout"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	defer stdout.Close()
+
+	os.Stdout = stdout
+
+	status := -1
+	exit = func(code int) {
+		status = code
+	}
+	os.Args = []string{"cantor", "subset", "-", writeFile(t, "a.txt", "a\n")}
+
+	main()
+
+	if status != statusTrue {
+		t.Errorf("expected exit status %d but got %d", statusTrue, status)
+	}
+}
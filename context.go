@@ -0,0 +1,177 @@
+package cantor
+
+import "context"
+
+// [WithContext] returns a data view of set, whose enumeration stops once ctx is done.
+// Derived sets are rebuilt, so that all operands driving the enumeration observe ctx.
+// This affects Elements, Size and all comparisons of the result and of sets derived from it.
+//
+// Since the methods of [ReadableSet] can not return errors, an interrupted enumeration
+// just appears to be complete. Use [SizeContext], [EqualsContext], [SubsetContext] and [EvaluateContext]
+// to receive the error of ctx instead.
+//
+// Iterations, which do not yield elements to cantor, for example inside of custom [ReadableSet] implementations,
+// can not be interrupted.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func WithContext[T comparable](ctx context.Context, set ReadableSet[T]) ReadableSet[T] {
+	return withContext(ctx, set, nil)
+}
+
+// withContext implements [WithContext]. If interrupted is not nil,
+// it receives the error of ctx once an enumeration is stopped before its end.
+func withContext[T comparable](ctx context.Context, set ReadableSet[T], interrupted *error) ReadableSet[T] {
+	switch set := set.(type) {
+	case union[T]:
+		args := make([]ReadableSet[T], 0, len(set.args))
+		for _, arg := range set.args {
+			args = append(args, withContext(ctx, arg, interrupted))
+		}
+
+		return newUnion(args...)
+	case intersection[T]:
+		return newIntersection(withContext(ctx, set.arg, interrupted), set.args...)
+	default:
+		return contextSet[T]{ctx: ctx, set: set, interrupted: interrupted}
+	}
+}
+
+// [ElementsContext] returns an [Iterator] over the elements of set, which stops once ctx is done.
+func ElementsContext[T comparable](ctx context.Context, set ReadableSet[T]) Iterator[T] {
+	return WithContext(ctx, set).Elements()
+}
+
+// [SizeContext] returns the number of elements in set.
+// If ctx is done before the evaluation completes, the error of ctx is returned.
+// A result, which was completed before ctx was done, is returned without error.
+//
+// ctx is only checked between elements, so a single long call to Contains of an operand can not be interrupted.
+// This applies to [EqualsContext], [SubsetContext] and [EvaluateContext] as well.
+func SizeContext[T comparable](ctx context.Context, set ReadableSet[T]) (int, error) {
+	var interrupted error
+
+	size := withContext(ctx, set, &interrupted).Size()
+	if interrupted != nil {
+		return 0, interrupted
+	}
+
+	return size, nil
+}
+
+// [EqualsContext] returns true, if both sets represent exactly the same elements.
+// If ctx is done before the evaluation completes, the error of ctx is returned.
+func EqualsContext[T comparable](ctx context.Context, set ReadableSet[T], other ReadableSet[T]) (bool, error) {
+	var interrupted error
+
+	empty := isEmpty(withContext(ctx, set, &interrupted).SymmetricDifference(withContext(ctx, other, &interrupted)))
+	if interrupted != nil {
+		return false, interrupted
+	}
+
+	return empty, nil
+}
+
+// [SubsetContext] returns true, if all elements of set are contained in the other container.
+// If ctx is done before the evaluation completes, the error of ctx is returned.
+func SubsetContext[T comparable](ctx context.Context, set ReadableSet[T], other Container[T]) (bool, error) {
+	var interrupted error
+
+	empty := isEmpty(withContext(ctx, set, &interrupted).Difference(other))
+	if interrupted != nil {
+		return false, interrupted
+	}
+
+	return empty, nil
+}
+
+// [EvaluateContext] evaluates set into a new [HashSet] like [NewHashSetFromIterator].
+// If ctx is done before the evaluation completes, the error of ctx is returned.
+func EvaluateContext[T comparable](ctx context.Context, set ReadableSet[T]) (HashSet[T], error) {
+	var interrupted error
+
+	result := NewHashSetFromIterator(withContext(ctx, set, &interrupted).Elements())
+	if interrupted != nil {
+		return nil, interrupted
+	}
+
+	return result, nil
+}
+
+// contextSet wraps a set, whose enumeration stops once ctx is done.
+type contextSet[T comparable] struct {
+	ctx context.Context
+	set ReadableSet[T]
+	// interrupted receives the error of ctx, if it is not nil and an enumeration was stopped.
+	interrupted *error
+}
+
+func (set contextSet[T]) Contains(element T) bool {
+	return set.set.Contains(element)
+}
+
+func (set contextSet[T]) Union(other ReadableSet[T]) ReadableSet[T] {
+	return newUnion[T](set, other)
+}
+
+func (set contextSet[T]) Intersect(other Container[T]) ReadableSet[T] {
+	return newIntersection[T](set, other)
+}
+
+func (set contextSet[T]) Complement() ImplicitSet[T] {
+	return set.set.Complement()
+}
+
+func (set contextSet[T]) Difference(other Container[T]) ReadableSet[T] {
+	return set.Intersect(negation[T]{arg: other})
+}
+
+func (set contextSet[T]) SymmetricDifference(other ReadableSet[T]) ReadableSet[T] {
+	return set.Difference(other).Union(other.Difference(set))
+}
+
+func (set contextSet[T]) Subset(other Container[T]) bool {
+	return set.Difference(other).Size() == 0
+}
+
+func (set contextSet[T]) StrictSubset(other ReadableSet[T]) bool {
+	return set.Difference(other).Size() == 0 && other.Difference(set).Size() > 0
+}
+
+func (set contextSet[T]) Equals(other ReadableSet[T]) bool {
+	return set.SymmetricDifference(other).Size() == 0
+}
+
+func (set contextSet[T]) Elements() Iterator[T] {
+	return func(yield func(element T) (next bool)) {
+		done := set.ctx.Done()
+
+		set.set.Elements()(func(element T) (next bool) {
+			select {
+			case <-done:
+				if set.interrupted != nil {
+					*set.interrupted = set.ctx.Err()
+				}
+
+				return false
+			default:
+				return yield(element)
+			}
+		})
+	}
+}
+
+func (set contextSet[T]) String() string {
+	return toString[T](set)
+}
+
+func (set contextSet[T]) Size() int {
+	return count(set.Elements())
+}
+
+func (set contextSet[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](set)
+}
+
+func (set contextSet[T]) SafeForConcurrentReads() bool {
+	return safeForConcurrentReads[T](set.set)
+}
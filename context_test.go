@@ -0,0 +1,189 @@
+package cantor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/sets"
+)
+
+func TestWithContext(t *testing.T) {
+	t.Run("hash set", func(t *testing.T) {
+		sets.RunTestsForReadableSet(t, func(elements ...byte) cantor.ReadableSet[byte] {
+			return cantor.WithContext[byte](context.Background(), cantor.NewHashSet(elements...))
+		})
+	})
+
+	t.Run("union", func(t *testing.T) {
+		sets.RunTestsForReadableSet(t, func(elements ...byte) cantor.ReadableSet[byte] {
+			a := cantor.NewHashSet(elements[:len(elements)/2]...)
+			b := cantor.NewHashSet(elements[len(elements)/2:]...)
+
+			return cantor.WithContext(context.Background(), a.Union(b))
+		})
+	})
+
+	t.Run("intersection", func(t *testing.T) {
+		sets.RunTestsForReadableSet(t, func(elements ...byte) cantor.ReadableSet[byte] {
+			a := cantor.NewHashSet(elements...)
+			b := cantor.NewHashSet(elements...)
+
+			return cantor.WithContext(context.Background(), a.Intersect(b))
+		})
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		sets.RunTestsForReadableSet(t, func(elements ...byte) cantor.ReadableSet[byte] {
+			ctx := context.Background()
+
+			return cantor.WithContext(ctx, cantor.WithContext[byte](ctx, cantor.NewHashSet(elements...)))
+		})
+	})
+
+	t.Run("concurrency safety", func(t *testing.T) {
+		set := cantor.WithContext[int](context.Background(), cantor.NewHashSet(1, 2))
+
+		size, err := cantor.ParallelSize(set, 2)
+		if err != nil || size != 2 {
+			t.Errorf("expected 2 but got %d (%v)", size, err)
+		}
+	})
+}
+
+// cancellingContainer cancels the context after the given number of calls to Contains.
+type cancellingContainer struct {
+	calls  *int
+	limit  int
+	cancel context.CancelFunc
+}
+
+func (container cancellingContainer) Contains(element int) bool {
+	*container.calls++
+	if *container.calls == container.limit {
+		container.cancel()
+	}
+
+	return false
+}
+
+func buildLargeSet(size int) cantor.HashSet[int] {
+	set := cantor.NewHashSet[int]()
+	for i := 0; i < size; i++ {
+		set.Add(i)
+	}
+
+	return set
+}
+
+func TestContext_cancellation(t *testing.T) {
+	large := buildLargeSet(100000)
+
+	t.Run("interrupts views without results", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		view := large.Union(large.Intersect(cantor.NewHashSet(-1))).Intersect(
+			cancellingContainer{calls: &calls, limit: 100, cancel: cancel},
+		)
+
+		_, err := cantor.SizeContext(ctx, view)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled but got %v", err)
+		}
+
+		if calls > 101 {
+			t.Errorf("evaluation continued for %d elements after cancellation", calls-100)
+		}
+	})
+
+	t.Run("keeps results completed before cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		calls := 0
+		// the context is cancelled during the membership check of the last element
+		view := cantor.NewHashSet(1, 2, 3).Difference(cancellingContainer{calls: &calls, limit: 3, cancel: cancel})
+
+		size, err := cantor.SizeContext(ctx, view)
+		if err != nil || size != 3 {
+			t.Errorf("expected 3 but got %d (%v)", size, err)
+		}
+
+		if ctx.Err() == nil {
+			t.Errorf("expected the context to be cancelled during the evaluation")
+		}
+	})
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	t.Run("nested contexts", func(t *testing.T) {
+		size, err := cantor.SizeContext(context.Background(), cantor.WithContext[int](cancelled, large))
+		if err != nil || size != 0 {
+			t.Errorf("expected the inner context to stop the enumeration but got %d (%v)", size, err)
+		}
+	})
+
+	t.Run("ElementsContext", func(t *testing.T) {
+		count := 0
+
+		cantor.ElementsContext[int](cancelled, large)(func(element int) (next bool) {
+			count++
+
+			return true
+		})
+
+		if count != 0 {
+			t.Errorf("expected no elements but got %d", count)
+		}
+	})
+
+	t.Run("SizeContext", func(t *testing.T) {
+		size, err := cantor.SizeContext(context.Background(), large.Difference(cantor.NewHashSet(1)))
+		if err != nil || size != 99999 {
+			t.Errorf("expected 99999 but got %d (%v)", size, err)
+		}
+	})
+
+	t.Run("EqualsContext", func(t *testing.T) {
+		equal, err := cantor.EqualsContext[int](context.Background(), large, large.Union(cantor.NewHashSet(1)))
+		if err != nil || !equal {
+			t.Errorf("expected equal sets but got %t (%v)", equal, err)
+		}
+
+		equal, err = cantor.EqualsContext[int](context.Background(), large, cantor.NewHashSet(1))
+		if err != nil || equal {
+			t.Errorf("expected unequal sets but got %t (%v)", equal, err)
+		}
+
+		_, err = cantor.EqualsContext[int](cancelled, large, large)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled but got %v", err)
+		}
+	})
+
+	t.Run("SubsetContext", func(t *testing.T) {
+		subset, err := cantor.SubsetContext[int](context.Background(), cantor.NewHashSet(1, 2), large)
+		if err != nil || !subset {
+			t.Errorf("expected subset but got %t (%v)", subset, err)
+		}
+
+		_, err = cantor.SubsetContext[int](cancelled, large, large)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled but got %v", err)
+		}
+	})
+
+	t.Run("EvaluateContext", func(t *testing.T) {
+		result, err := cantor.EvaluateContext(context.Background(), large.Intersect(cantor.NewHashSet(1, -1)))
+		if err != nil || !result.Equals(cantor.NewHashSet(1)) {
+			t.Errorf("expected {1} but got %v (%v)", result, err)
+		}
+
+		_, err = cantor.EvaluateContext[int](cancelled, large)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled but got %v", err)
+		}
+	})
+}
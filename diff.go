@@ -0,0 +1,157 @@
+package cantor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// [SetDiff] describes the changes between two versions of a set. It is created by [Diff] and applied by [Patch].
+//
+// Nil views are treated as empty sets, so the zero value describes no changes.
+type SetDiff[T comparable] struct {
+	// Added contains the elements, which are only contained in the new version.
+	Added ReadableSet[T]
+	// Removed contains the elements, which are only contained in the old version.
+	Removed ReadableSet[T]
+	// Unchanged contains the elements, which are contained in both versions.
+	Unchanged ReadableSet[T]
+}
+
+// [Diff] returns a [SetDiff] describing the changes from oldSet to newSet.
+//
+// The result consists of data views and will reflect future changes of the underlying structures.
+// Use [Patch] to apply the changes to another set.
+func Diff[T comparable](oldSet, newSet ReadableSet[T]) SetDiff[T] {
+	return SetDiff[T]{
+		Added:     newSet.Difference(oldSet),
+		Removed:   oldSet.Difference(newSet),
+		Unchanged: oldSet.Intersect(newSet),
+	}
+}
+
+// [Patch] adds all added elements of the diff to the set and removes all removed elements from it.
+// It returns true, if this actually changed the set.
+//
+// The changes are collected before they are applied, so the diff may be a view of the set itself.
+func Patch[T comparable](set Set[T], diff SetDiff[T]) (modified bool) {
+	added := NewHashSetFromIterator(orEmptyView(diff.Added).Elements())
+	removed := NewHashSetFromIterator(orEmptyView(diff.Removed).Elements())
+
+	for element := range removed {
+		modified = set.Remove(element) || modified
+	}
+
+	for element := range added {
+		modified = set.Add(element) || modified
+	}
+
+	return modified
+}
+
+// Empty returns true, if no elements were added or removed.
+func (diff SetDiff[T]) Empty() bool {
+	return isEmpty(diff.Added) && isEmpty(diff.Removed)
+}
+
+// String returns a human-readable report of the changes with one line per changed element.
+// Removed elements are prefixed with "- " and added elements with "+ ". Unchanged elements are omitted.
+// Both groups are sorted by their text, so the report is stable and can be logged.
+func (diff SetDiff[T]) String() string {
+	lines := append(diffLines("- ", diff.Removed), diffLines("+ ", diff.Added)...)
+
+	return strings.Join(lines, "\n")
+}
+
+func diffLines[T comparable](prefix string, set ReadableSet[T]) []string {
+	var lines []string
+
+	if set == nil {
+		return lines
+	}
+
+	set.Elements()(func(element T) (next bool) {
+		lines = append(lines, prefix+quoteElement(fmt.Sprint(element)))
+
+		return true
+	})
+
+	sort.Strings(lines)
+
+	return lines
+}
+
+// MarshalJSON implements [json.Marshaler]. The diff is encoded as JSON object
+// with the arrays "added", "removed" and "unchanged", which are sorted like by [MarshalJSONSorted].
+func (diff SetDiff[T]) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+
+	buffer.WriteByte('{')
+
+	for i, field := range []struct {
+		name string
+		set  ReadableSet[T]
+	}{
+		{name: "added", set: orEmptyView(diff.Added)},
+		{name: "removed", set: orEmptyView(diff.Removed)},
+		{name: "unchanged", set: orEmptyView(diff.Unchanged)},
+	} {
+		elements, err := MarshalJSONSorted(field.set, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+
+		fmt.Fprintf(&buffer, "%q:", field.name)
+		buffer.Write(elements)
+	}
+
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON implements [json.Unmarshaler] for [SetDiff] and decodes the format written by [SetDiff.MarshalJSON].
+// The decoded views are backed by new instances of [HashSet]. Missing arrays are decoded as empty sets.
+func (diff *SetDiff[T]) UnmarshalJSON(data []byte) error {
+	var decoded struct {
+		Added     HashSet[T] `json:"added"`
+		Removed   HashSet[T] `json:"removed"`
+		Unchanged HashSet[T] `json:"unchanged"`
+	}
+
+	err := json.Unmarshal(data, &decoded)
+	if err != nil {
+		return err
+	}
+
+	*diff = SetDiff[T]{
+		Added:     orEmpty(decoded.Added),
+		Removed:   orEmpty(decoded.Removed),
+		Unchanged: orEmpty(decoded.Unchanged),
+	}
+
+	return nil
+}
+
+// orEmptyView returns the view or an empty set, if the view is nil.
+func orEmptyView[T comparable](view ReadableSet[T]) ReadableSet[T] {
+	if view == nil {
+		return NewHashSet[T]()
+	}
+
+	return view
+}
+
+func orEmpty[T comparable](set HashSet[T]) HashSet[T] {
+	if set == nil {
+		return NewHashSet[T]()
+	}
+
+	return set
+}
@@ -0,0 +1,185 @@
+package cantor_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+func TestDiff(t *testing.T) {
+	oldSet := cantor.NewHashSet("read", "write", "delete")
+	newSet := cantor.NewHashSet("read", "write", "admin")
+	diff := cantor.Diff[string](oldSet, newSet)
+
+	switch {
+	case !diff.Added.Equals(cantor.NewHashSet("admin")):
+		t.Errorf("unexpected added elements %v", diff.Added)
+	case !diff.Removed.Equals(cantor.NewHashSet("delete")):
+		t.Errorf("unexpected removed elements %v", diff.Removed)
+	case !diff.Unchanged.Equals(cantor.NewHashSet("read", "write")):
+		t.Errorf("unexpected unchanged elements %v", diff.Unchanged)
+	case diff.Empty():
+		t.Errorf("expected the diff not to be empty")
+	}
+
+	// the diff is a data view
+	newSet.Add("delete")
+
+	if !diff.Removed.Equals(cantor.NewHashSet[string]()) || !diff.Unchanged.Contains("delete") {
+		t.Errorf("expected the diff to reflect changes but got %v", diff)
+	}
+}
+
+func TestSetDiff_Empty(t *testing.T) {
+	tests := map[string]struct {
+		oldSet, newSet cantor.ReadableSet[int]
+		expected       bool
+	}{
+		"empty":   {oldSet: cantor.NewHashSet[int](), newSet: cantor.NewHashSet[int](), expected: true},
+		"equal":   {oldSet: cantor.NewHashSet(1, 2), newSet: cantor.NewHashSet(2, 1), expected: true},
+		"added":   {oldSet: cantor.NewHashSet(1), newSet: cantor.NewHashSet(1, 2), expected: false},
+		"removed": {oldSet: cantor.NewHashSet(1, 2), newSet: cantor.NewHashSet(1), expected: false},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			if actual := cantor.Diff(test.oldSet, test.newSet).Empty(); actual != test.expected {
+				t.Errorf("expected %v but got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSetDiff_String(t *testing.T) {
+	tests := map[string]struct {
+		oldSet, newSet cantor.ReadableSet[string]
+		expected       string
+	}{
+		"empty":     {oldSet: cantor.NewHashSet("a"), newSet: cantor.NewHashSet("a"), expected: ""},
+		"added":     {oldSet: cantor.NewHashSet("a"), newSet: cantor.NewHashSet("c", "a", "b"), expected: "+ b\n+ c"},
+		"removed":   {oldSet: cantor.NewHashSet("b", "a"), newSet: cantor.NewHashSet[string](), expected: "- a\n- b"},
+		"quoted":    {oldSet: cantor.NewHashSet[string](), newSet: cantor.NewHashSet("a, b"), expected: `+ "a, b"`},
+		"changes":   {oldSet: cantor.NewHashSet("a", "b"), newSet: cantor.NewHashSet("b", "c"), expected: "- a\n+ c"},
+		"unchanged": {oldSet: cantor.NewHashSet("a", "b"), newSet: cantor.NewHashSet("b"), expected: "- a"},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			if actual := cantor.Diff(test.oldSet, test.newSet).String(); actual != test.expected {
+				t.Errorf("expected %q but got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSetDiff_MarshalJSON(t *testing.T) {
+	diff := cantor.Diff[int](cantor.NewHashSet(1, 2, 3, 4), cantor.NewHashSet(4, 3, 6, 5))
+
+	data, err := json.Marshal(diff)
+	if err != nil || string(data) != `{"added":[5,6],"removed":[1,2],"unchanged":[3,4]}` {
+		t.Errorf("unexpected result %s (%v)", data, err)
+	}
+
+	var decoded cantor.SetDiff[int]
+
+	err = json.Unmarshal(data, &decoded)
+	if err != nil || decoded.String() != diff.String() || !decoded.Unchanged.Equals(diff.Unchanged) {
+		t.Errorf("expected %v but got %v (%v)", diff, decoded, err)
+	}
+
+	_, err = json.Marshal(cantor.Diff[chan int](cantor.NewHashSet[chan int](), cantor.NewHashSet(make(chan int))))
+	if err == nil {
+		t.Errorf("expected an error for unsupported type")
+	}
+}
+
+func TestSetDiff_UnmarshalJSON(t *testing.T) {
+	var diff cantor.SetDiff[int]
+
+	err := json.Unmarshal([]byte(`{"added":[1]}`), &diff)
+	if err != nil || diff.String() != "+ 1" || diff.Unchanged.Size() != 0 {
+		t.Errorf("expected missing arrays to be empty but got %v (%v)", diff, err)
+	}
+
+	err = json.Unmarshal([]byte(`{"added":["a"]}`), &diff)
+	if err == nil {
+		t.Errorf("expected an error for invalid elements")
+	}
+}
+
+func TestSetDiff_zero(t *testing.T) {
+	var diff cantor.SetDiff[int]
+
+	if !diff.Empty() || diff.String() != "" {
+		t.Errorf("expected the zero diff to be empty but got %q", diff)
+	}
+
+	data, err := json.Marshal(diff)
+	if err != nil || string(data) != `{"added":[],"removed":[],"unchanged":[]}` {
+		t.Errorf("unexpected result %s (%v)", data, err)
+	}
+
+	set := cantor.NewHashSet(1)
+	if cantor.Patch[int](set, diff) || !set.Equals(cantor.NewHashSet(1)) {
+		t.Errorf("expected the zero diff not to modify the set but got %v", set)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	tests := map[string]struct {
+		set      cantor.HashSet[int]
+		expected cantor.HashSet[int]
+		modified bool
+	}{
+		"old version":      {set: cantor.NewHashSet(1, 2, 3), expected: cantor.NewHashSet(2, 3, 4), modified: true},
+		"new version":      {set: cantor.NewHashSet(2, 3, 4), expected: cantor.NewHashSet(2, 3, 4), modified: false},
+		"diverged version": {set: cantor.NewHashSet(1, 5), expected: cantor.NewHashSet(4, 5), modified: true},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			diff := cantor.Diff[int](cantor.NewHashSet(1, 2, 3), cantor.NewHashSet(2, 3, 4))
+
+			modified := cantor.Patch[int](test.set, diff)
+			if modified != test.modified || !test.set.Equals(test.expected) {
+				t.Errorf("expected %v (%v) but got %v (%v)", test.expected, test.modified, test.set, modified)
+			}
+		})
+	}
+
+	t.Run("view of the patched set", func(t *testing.T) {
+		set := cantor.NewHashSet(1, 2, 3)
+
+		if !cantor.Patch[int](set, cantor.Diff[int](set, cantor.NewHashSet(3, 4))) {
+			t.Errorf("expected the set to be modified")
+		}
+
+		if !set.Equals(cantor.NewHashSet(3, 4)) {
+			t.Errorf("expected {3, 4} but got %v", set)
+		}
+	})
+}
+
+func ExampleDiff() {
+	granted := cantor.NewHashSet("read", "write", "delete")
+	requested := cantor.NewHashSet("read", "write", "admin")
+
+	diff := cantor.Diff[string](granted, requested)
+	fmt.Println(diff)
+
+	replica := cantor.NewHashSet("read", "write", "delete")
+	cantor.Patch[string](replica, diff)
+	fmt.Println(replica.Equals(requested))
+	// Output:
+	// - delete
+	// + admin
+	// true
+}
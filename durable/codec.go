@@ -0,0 +1,41 @@
+package durable
+
+import "encoding/json"
+
+// [Codec] converts elements to bytes and back. Decode must return an element equal to the encoded one.
+type Codec[T any] interface {
+	Encode(element T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+type jsonCodec[T any] struct{}
+
+// [JSONCodec] returns a [Codec], which encodes elements using [encoding/json].
+func JSONCodec[T any]() Codec[T] {
+	return jsonCodec[T]{}
+}
+
+func (jsonCodec[T]) Encode(element T) ([]byte, error) {
+	return json.Marshal(element)
+}
+
+func (jsonCodec[T]) Decode(data []byte) (element T, err error) {
+	err = json.Unmarshal(data, &element)
+
+	return element, err
+}
+
+type stringCodec struct{}
+
+// [StringCodec] returns a [Codec], which stores strings as their raw bytes.
+func StringCodec() Codec[string] {
+	return stringCodec{}
+}
+
+func (stringCodec) Encode(element string) ([]byte, error) {
+	return []byte(element), nil
+}
+
+func (stringCodec) Decode(data []byte) (string, error) {
+	return string(data), nil
+}
@@ -0,0 +1,532 @@
+// Package durable implements sets, which persist their changes to disk and survive restarts.
+//
+// A [DurableSet] keeps its elements in memory like a [cantor.HashSet].
+// Every change is appended to a write-ahead log before it becomes visible.
+// The log is periodically compacted into a snapshot of all elements.
+// When opening a set, the snapshot is loaded and the log is replayed.
+// Records, which were only partially written because of a crash, are detected by their checksum and discarded.
+package durable
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+const (
+	logName      = "wal"
+	snapshotName = "snapshot"
+)
+
+var (
+	// [ErrCorrupted] is returned when opening a [DurableSet], whose files are damaged beyond a torn write.
+	ErrCorrupted = errors.New("durable: corrupted data")
+	// [ErrClosed] is returned when modifying a closed [DurableSet].
+	ErrClosed = errors.New("durable: set is closed")
+)
+
+// [SyncPolicy] defines, when changes are flushed to stable storage using fsync.
+type SyncPolicy int
+
+const (
+	// [SyncAlways] flushes every change before it becomes visible. No acknowledged change is lost on a crash.
+	SyncAlways SyncPolicy = iota
+	// [SyncInterval] flushes a change, if the last flush is longer ago than [Options.SyncInterval].
+	// On a crash, changes of the last interval might be lost.
+	SyncInterval
+	// [SyncNever] leaves flushing to the operating system, [DurableSet.Sync] and [DurableSet.Close].
+	// Changes are only lost, if the operating system crashes.
+	SyncNever
+)
+
+// [Options] configures a [DurableSet]. The zero value uses JSON and flushes every change.
+type Options[T any] struct {
+	// Codec encodes the elements. If it is nil, [JSONCodec] is used.
+	Codec Codec[T]
+	// Sync defines, when changes are flushed to stable storage.
+	Sync SyncPolicy
+	// SyncInterval is the maximum time between flushes for [SyncInterval].
+	SyncInterval time.Duration
+	// CompactAfter is the number of log records, after which the log is compacted into a snapshot.
+	// If it is not positive, the log is only compacted by [DurableSet.Compact].
+	CompactAfter int
+}
+
+// [DurableSet] implements [cantor.Set] and persists all changes in a directory.
+//
+// Since the methods of [cantor.Set] can not return errors, a failed write rejects the change
+// and is reported by [DurableSet.Err]. Afterwards, all further changes are rejected.
+// A [DurableSet] can be read concurrently, as long as it is not modified at the same time.
+// The directory must not be used by multiple sets at once.
+type DurableSet[T comparable] struct {
+	dir      string
+	options  Options[T]
+	elements cantor.HashSet[T]
+	log      file
+	size     int64
+	records  int
+	lastSync time.Time
+	err      error
+}
+
+// [Open] opens the set persisted in dir and creates dir, if it does not exist.
+// The snapshot is loaded and the log is replayed. A torn write at the end of the log is discarded.
+//
+// It returns [ErrCorrupted], if the files are damaged otherwise or can not be decoded.
+func Open[T comparable](dir string, options Options[T]) (*DurableSet[T], error) {
+	if options.Codec == nil {
+		options.Codec = JSONCodec[T]()
+	}
+
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &DurableSet[T]{dir: dir, options: options, elements: cantor.NewHashSet[T](), lastSync: time.Now()}
+
+	err = set.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+func (set *DurableSet[T]) load() error {
+	err := set.loadSnapshot()
+	if err != nil {
+		return err
+	}
+
+	data, err := readFile(filepath.Join(set.dir, logName))
+	if err != nil {
+		return err
+	}
+
+	valid, err := readRecords(data, set.apply)
+	if err != nil {
+		return fmt.Errorf("%s: %w", logName, err)
+	}
+
+	set.log, err = openFile(filepath.Join(set.dir, logName), os.O_WRONLY|os.O_CREATE|os.O_APPEND)
+	if err != nil {
+		return err
+	}
+
+	set.size = int64(valid)
+	if valid < len(data) {
+		err = set.truncate(set.size)
+	}
+
+	return err
+}
+
+// loadSnapshot reads the snapshot. Unlike the log, it is replaced atomically and must never be incomplete.
+func (set *DurableSet[T]) loadSnapshot() error {
+	snapshot, err := readFile(filepath.Join(set.dir, snapshotName))
+	if err != nil {
+		return err
+	}
+
+	valid, err := readRecords(snapshot, set.apply)
+	if err == nil && valid < len(snapshot) {
+		err = fmt.Errorf("%w: incomplete snapshot", ErrCorrupted)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", snapshotName, err)
+	}
+
+	return nil
+}
+
+// truncate discards the log after size bytes.
+func (set *DurableSet[T]) truncate(size int64) error {
+	err := set.log.Truncate(size)
+	if err == nil {
+		err = set.log.Sync()
+	}
+
+	if err != nil {
+		_ = set.log.Close()
+	}
+
+	return err
+}
+
+func readFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	return data, err
+}
+
+// apply replays an entry of the log or the snapshot.
+func (set *DurableSet[T]) apply(entry entry) error {
+	if entry.op == opClear {
+		set.elements.Clear()
+
+		return nil
+	}
+
+	element, err := set.options.Codec.Decode(entry.data)
+	if err != nil {
+		return err
+	}
+
+	switch entry.op {
+	case opAdd:
+		set.elements.Add(element)
+	case opRemove:
+		set.elements.Remove(element)
+	default:
+		return fmt.Errorf("unknown operation %d", entry.op)
+	}
+
+	return nil
+}
+
+// write appends the entries as a single record to the log and returns true, if this succeeded.
+func (set *DurableSet[T]) write(entries []entry) bool {
+	if set.err != nil {
+		return false
+	}
+
+	record := appendRecord(nil, entries)
+
+	_, set.err = set.log.Write(record)
+	if set.err == nil && set.needsSync() {
+		set.err = set.Sync()
+	}
+
+	if set.err != nil {
+		// the change is rejected, so it must not be replayed, even if it was written completely
+		_ = set.log.Truncate(set.size)
+
+		return false
+	}
+
+	set.size += int64(len(record))
+	set.records++
+
+	return true
+}
+
+func (set *DurableSet[T]) needsSync() bool {
+	switch set.options.Sync {
+	case SyncAlways:
+		return true
+	case SyncInterval:
+		return time.Since(set.lastSync) >= set.options.SyncInterval
+	default:
+		return false
+	}
+}
+
+// encode encodes the elements as entries with the given operation. Errors are recorded like write errors.
+func (set *DurableSet[T]) encode(op byte, elements ...T) ([]entry, bool) {
+	if set.err != nil {
+		return nil, false
+	}
+
+	entries := make([]entry, 0, len(elements))
+
+	for _, element := range elements {
+		data, err := set.options.Codec.Encode(element)
+		if err != nil {
+			set.err = err
+
+			return nil, false
+		}
+
+		entries = append(entries, entry{op: op, data: data})
+	}
+
+	return entries, true
+}
+
+// commit writes the entries and applies them in memory, if the write succeeded.
+func (set *DurableSet[T]) commit(entries []entry, ok bool) bool {
+	if !ok || len(entries) == 0 || !set.write(entries) {
+		return false
+	}
+
+	for _, entry := range entries {
+		// the entries were just encoded, so decoding them again can not fail for a valid codec
+		_ = set.apply(entry)
+	}
+
+	if set.options.CompactAfter > 0 && set.records >= set.options.CompactAfter {
+		set.err = set.Compact()
+	}
+
+	return true
+}
+
+// Err returns the first error, which occurred while persisting a change, or nil.
+func (set *DurableSet[T]) Err() error {
+	return set.err
+}
+
+// Sync flushes all changes to stable storage.
+func (set *DurableSet[T]) Sync() error {
+	if set.err != nil {
+		return set.err
+	}
+
+	err := set.log.Sync()
+	if err != nil {
+		return err
+	}
+
+	set.lastSync = time.Now()
+
+	return nil
+}
+
+// Compact writes a snapshot of all elements and empties the log.
+// The snapshot is replaced atomically, so a crash during compaction does not lose any changes.
+func (set *DurableSet[T]) Compact() error {
+	if set.err != nil {
+		return set.err
+	}
+
+	var (
+		snapshot []byte
+		chunk    []T
+	)
+
+	for element := range set.elements {
+		chunk = append(chunk, element)
+		if len(chunk) == snapshotChunkSize {
+			snapshot = set.appendSnapshot(snapshot, chunk)
+			chunk = chunk[:0]
+		}
+	}
+
+	snapshot = set.appendSnapshot(snapshot, chunk)
+	if set.err != nil {
+		return set.err
+	}
+
+	err := writeFile(set.dir, snapshotName, snapshot)
+	if err == nil {
+		// replaying the log on top of the new snapshot would not change it, so a crash at this point is harmless
+		err = set.log.Truncate(0)
+	}
+
+	if err == nil {
+		set.size = 0
+		err = set.Sync()
+	}
+
+	set.records = 0
+
+	return err
+}
+
+func (set *DurableSet[T]) appendSnapshot(snapshot []byte, chunk []T) []byte {
+	entries, ok := set.encode(opAdd, chunk...)
+	if !ok || len(entries) == 0 {
+		return snapshot
+	}
+
+	return appendRecord(snapshot, entries)
+}
+
+// Close flushes all changes and closes the log. Afterwards, the set can still be read, but not modified.
+func (set *DurableSet[T]) Close() error {
+	if errors.Is(set.err, ErrClosed) {
+		return ErrClosed
+	}
+
+	err := set.log.Sync()
+	closeErr := set.log.Close()
+
+	set.err = ErrClosed
+
+	if err != nil {
+		return err
+	}
+
+	return closeErr
+}
+
+// Add adds element and returns true if this operation actually changed the set.
+// The change is logged before it becomes visible. If logging fails, the set is unchanged and false is returned.
+//
+// Data views derived from this set will reflect the change.
+func (set *DurableSet[T]) Add(element T) (modified bool) {
+	if set.elements.Contains(element) {
+		return false
+	}
+
+	return set.commit(set.encode(opAdd, element))
+}
+
+// Remove removes element and returns true if this operation actually changed the set.
+// The change is logged before it becomes visible. If logging fails, the set is unchanged and false is returned.
+//
+// Data views derived from this set will reflect the change.
+func (set *DurableSet[T]) Remove(element T) (modified bool) {
+	if !set.elements.Contains(element) {
+		return false
+	}
+
+	return set.commit(set.encode(opRemove, element))
+}
+
+// AddAll adds all elements of the iterator and returns the number of elements, which were actually added.
+// All changes are logged as a single record, so either all or none of them survive a crash.
+//
+// Data views derived from this set will reflect the change.
+func (set *DurableSet[T]) AddAll(iterator cantor.Iterator[T]) (modified int) {
+	added := cantor.NewHashSetFromIterator(iterator)
+
+	return set.commitAll(opAdd, added.Difference(set.elements))
+}
+
+// RemoveAll removes all elements, which are contained in the other container,
+// and returns the number of elements, which were actually removed.
+// All changes are logged as a single record, so either all or none of them survive a crash.
+//
+// Data views derived from this set will reflect the change.
+func (set *DurableSet[T]) RemoveAll(other cantor.Container[T]) (modified int) {
+	return set.commitAll(opRemove, set.elements.Intersect(other))
+}
+
+// RetainAll removes all elements, which are not contained in the other container,
+// and returns the number of elements, which were actually removed.
+// All changes are logged as a single record, so either all or none of them survive a crash.
+//
+// Data views derived from this set will reflect the change.
+func (set *DurableSet[T]) RetainAll(other cantor.Container[T]) (modified int) {
+	return set.commitAll(opRemove, set.elements.Difference(other))
+}
+
+// Clear removes all elements and returns the number of elements, which were actually removed.
+//
+// Data views derived from this set will reflect the change.
+func (set *DurableSet[T]) Clear() (modified int) {
+	modified = set.elements.Size()
+	if modified == 0 || !set.commit([]entry{{op: opClear}}, true) {
+		return 0
+	}
+
+	return modified
+}
+
+// commitAll evaluates the changed elements before applying them, since they are a view of this set.
+func (set *DurableSet[T]) commitAll(op byte, changed cantor.ReadableSet[T]) (modified int) {
+	var elements []T
+
+	changed.Elements()(func(element T) bool {
+		elements = append(elements, element)
+
+		return true
+	})
+
+	if !set.commit(set.encode(op, elements...)) {
+		return 0
+	}
+
+	return len(elements)
+}
+
+// Contains returns whether the element is contained in this set.
+//
+// The time complexity of this method is O(1).
+func (set *DurableSet[T]) Contains(element T) bool {
+	return set.elements.Contains(element)
+}
+
+// Union returns a [cantor.ReadableSet] representing the set union of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *DurableSet[T]) Union(other cantor.ReadableSet[T]) cantor.ReadableSet[T] {
+	return cantor.NewUnion[T](set, other)
+}
+
+// Intersect returns a [cantor.ReadableSet] representing the set intersection of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *DurableSet[T]) Intersect(other cantor.Container[T]) cantor.ReadableSet[T] {
+	return cantor.NewIntersection[T](set, other)
+}
+
+// Complement returns a [cantor.ImplicitSet], representing all elements not contained in this set.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *DurableSet[T]) Complement() cantor.ImplicitSet[T] {
+	return cantor.NewImplicitSet(func(element T) bool {
+		return !set.Contains(element)
+	})
+}
+
+// Difference returns a [cantor.ReadableSet] with all elements of this set, which are not contained in the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *DurableSet[T]) Difference(other cantor.Container[T]) cantor.ReadableSet[T] {
+	return cantor.NewDifference[T](set, other)
+}
+
+// SymmetricDifference returns a [cantor.ReadableSet] representing the set with all elements of this
+// and the other set, which are contained in exactly one of the two.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *DurableSet[T]) SymmetricDifference(other cantor.ReadableSet[T]) cantor.ReadableSet[T] {
+	return set.Difference(other).Union(other.Difference(set))
+}
+
+// Equals returns true, if this set and the other set represent exactly the same elements.
+func (set *DurableSet[T]) Equals(other cantor.ReadableSet[T]) bool {
+	return set.SymmetricDifference(other).Size() == 0
+}
+
+// Subset returns true, if all elements of this set are contained in the other container.
+func (set *DurableSet[T]) Subset(other cantor.Container[T]) bool {
+	return set.Difference(other).Size() == 0
+}
+
+// StrictSubset returns true, if all elements of this set are contained in the other set
+// and the sets are not equal.
+func (set *DurableSet[T]) StrictSubset(other cantor.ReadableSet[T]) bool {
+	return set.Subset(other) && !set.Equals(other)
+}
+
+// Elements returns a [cantor.Iterator] over the elements of this set in no particular order.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *DurableSet[T]) Elements() cantor.Iterator[T] {
+	return set.elements.Elements()
+}
+
+// Size returns the number of elements in this set.
+//
+// The time complexity of this method is O(1).
+func (set *DurableSet[T]) Size() int {
+	return set.elements.Size()
+}
+
+// String implements [fmt.Stringer] for this set.
+func (set *DurableSet[T]) String() string {
+	return set.elements.String()
+}
+
+// MarshalJSON implements [json.Marshaler] and encodes the set as a JSON array of its elements in no particular order.
+func (set *DurableSet[T]) MarshalJSON() ([]byte, error) {
+	return set.elements.MarshalJSON()
+}
+
+// SafeForConcurrentReads implements [cantor.ConcurrentReader] and returns true,
+// since the set can be read concurrently as long as it is not modified at the same time.
+func (set *DurableSet[T]) SafeForConcurrentReads() bool {
+	return true
+}
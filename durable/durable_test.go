@@ -0,0 +1,392 @@
+package durable_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/durable"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/sets"
+)
+
+func open[T comparable](t *testing.T, dir string, options durable.Options[T]) *durable.DurableSet[T] {
+	t.Helper()
+
+	set, err := durable.Open(dir, options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = set.Close()
+	})
+
+	return set
+}
+
+func TestDurableSet_Set(t *testing.T) {
+	sets.RunTestsForSet(t, func(elements ...byte) cantor.Set[byte] {
+		set := open(t, t.TempDir(), durable.Options[byte]{Sync: durable.SyncNever})
+		for _, element := range elements {
+			set.Add(element)
+		}
+
+		return set
+	})
+}
+
+func TestDurableSet_persistence(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		options durable.Options[int]
+	}{
+		{name: "sync always", options: durable.Options[int]{}},
+		{name: "sync interval", options: durable.Options[int]{Sync: durable.SyncInterval, SyncInterval: time.Hour}},
+		{name: "sync interval elapsed", options: durable.Options[int]{Sync: durable.SyncInterval}},
+		{name: "sync never", options: durable.Options[int]{Sync: durable.SyncNever}},
+		{name: "compact", options: durable.Options[int]{CompactAfter: 3}},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			set := open(t, dir, test.options)
+
+			set.Add(1)
+			set.Add(2)
+			set.Add(3)
+			set.Remove(2)
+			set.AddAll(cantor.NewHashSet(4, 5, 6).Elements())
+			set.RemoveAll(cantor.NewHashSet(5))
+			set.RetainAll(cantor.NewHashSet(1, 3, 4, 5))
+
+			if err := set.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			reopened := open(t, dir, test.options)
+			if !reopened.Equals(cantor.NewHashSet(1, 3, 4)) {
+				t.Errorf("expected %v, got %v", cantor.NewHashSet(1, 3, 4), reopened)
+			}
+
+			if reopened.Clear() != 3 {
+				t.Errorf("expected 3 elements to be cleared")
+			}
+
+			if err := reopened.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if open(t, dir, test.options).Size() != 0 {
+				t.Errorf("expected the set to be empty after clearing it")
+			}
+		})
+	}
+}
+
+func TestDurableSet_Compact(t *testing.T) {
+	dir := t.TempDir()
+	set := open(t, dir, durable.Options[int]{Sync: durable.SyncNever})
+
+	for i := 0; i < 3000; i++ {
+		set.Add(i)
+	}
+
+	if err := set.Compact(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if info, err := os.Stat(filepath.Join(dir, "wal")); err != nil || info.Size() != 0 {
+		t.Errorf("expected the log to be empty after compaction, got %v, %v", info, err)
+	}
+
+	set.Remove(0)
+
+	if err := set.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reopened := open(t, dir, durable.Options[int]{})
+	if reopened.Size() != 2999 || reopened.Contains(0) || !reopened.Contains(2999) {
+		t.Errorf("unexpected elements after reopening: %d", reopened.Size())
+	}
+
+	if err := reopened.Compact(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reopened.Clear()
+
+	if err := reopened.Compact(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if info, err := os.Stat(filepath.Join(dir, "snapshot")); err != nil || info.Size() != 0 {
+		t.Errorf("expected the snapshot of an empty set to be empty, got %v, %v", info, err)
+	}
+}
+
+func TestDurableSet_recovery(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		damage   func(data []byte) []byte
+		expected cantor.HashSet[string]
+	}{
+		{
+			name:     "intact",
+			damage:   func(data []byte) []byte { return data },
+			expected: cantor.NewHashSet("a", "b", "c"),
+		},
+		{
+			name:     "incomplete header",
+			damage:   func(data []byte) []byte { return append(data, 1, 2, 3) },
+			expected: cantor.NewHashSet("a", "b", "c"),
+		},
+		{
+			name:     "incomplete payload",
+			damage:   func(data []byte) []byte { return data[:len(data)-1] },
+			expected: cantor.NewHashSet("a", "b"),
+		},
+		{
+			name: "damaged last record",
+			damage: func(data []byte) []byte {
+				data[len(data)-1] = 'x'
+
+				return data
+			},
+			expected: cantor.NewHashSet("a", "b"),
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			options := durable.Options[string]{Codec: durable.StringCodec()}
+			set := open(t, dir, options)
+
+			set.Add("a")
+			set.Add("b")
+			set.Add("c")
+
+			if err := set.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			damage(t, filepath.Join(dir, "wal"), test.damage)
+
+			reopened := open(t, dir, options)
+			if !reopened.Equals(test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, reopened)
+			}
+
+			// new records must not be appended to the torn tail
+			reopened.Add("d")
+
+			if err := reopened.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			test.expected.Add("d")
+
+			if again := open(t, dir, options); !again.Equals(test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, again)
+			}
+		})
+	}
+}
+
+func TestOpen_errors(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		setup func(t *testing.T, dir string)
+	}{
+		{
+			name: "damaged record in the middle of the log",
+			setup: func(t *testing.T, dir string) {
+				write(t, dir, []string{"a", "b"}, []string{"c"})
+				damage(t, filepath.Join(dir, "wal"), func(data []byte) []byte {
+					data[10] = 'x'
+
+					return data
+				})
+			},
+		},
+		{
+			name: "damaged length in the middle of the log",
+			setup: func(t *testing.T, dir string) {
+				write(t, dir, []string{"a", "b"}, []string{"c"})
+				damage(t, filepath.Join(dir, "wal"), func(data []byte) []byte {
+					// the first record seems to exceed the log, which must not be mistaken for a torn write
+					data[3] = 0xff
+
+					return data
+				})
+			},
+		},
+		{
+			name: "incomplete snapshot",
+			setup: func(t *testing.T, dir string) {
+				write(t, dir, []string{"a"})
+				compact(t, dir)
+				damage(t, filepath.Join(dir, "snapshot"), func(data []byte) []byte { return data[:len(data)-1] })
+			},
+		},
+		{
+			name: "damaged snapshot",
+			setup: func(t *testing.T, dir string) {
+				write(t, dir, []string{"a"})
+				compact(t, dir)
+				damage(t, filepath.Join(dir, "snapshot"), func(data []byte) []byte {
+					damaged := append([]byte(nil), data...)
+					damaged[10] = 'x'
+
+					return append(damaged, data...)
+				})
+			},
+		},
+		{
+			name: "invalid entry length",
+			setup: func(t *testing.T, dir string) {
+				write(t, dir, []string{"a"})
+				damage(t, filepath.Join(dir, "wal"), func(data []byte) []byte {
+					// the entry exceeds the record, but the checksum is valid
+					return checksum(append(data[:13:13], 10, 'a'))
+				})
+			},
+		},
+		{
+			name: "unknown operation",
+			setup: func(t *testing.T, dir string) {
+				write(t, dir, []string{"a"})
+				damage(t, filepath.Join(dir, "wal"), func(data []byte) []byte {
+					data[12] = 42
+
+					return checksum(data)
+				})
+			},
+		},
+		{
+			name: "undecodable element",
+			setup: func(t *testing.T, dir string) {
+				write(t, dir, []string{"a"})
+				damage(t, filepath.Join(dir, "wal"), func(data []byte) []byte {
+					return append(data, checksum([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 1, '{'})...)
+				})
+			},
+		},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			test.setup(t, dir)
+
+			_, err := durable.Open(dir, durable.Options[string]{})
+			if !errors.Is(err, durable.ErrCorrupted) {
+				t.Errorf("expected %v, got %v", durable.ErrCorrupted, err)
+			}
+		})
+	}
+
+	t.Run("directory is a file", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "file")
+		if err := os.WriteFile(dir, nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := durable.Open[int](dir, durable.Options[int]{}); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+
+	for _, name := range []string{"snapshot", "wal"} {
+		name := name
+
+		t.Run(name+" is a directory", func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.Mkdir(filepath.Join(dir, name), 0o700); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := durable.Open[int](dir, durable.Options[int]{}); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestDurableSet_errors(t *testing.T) {
+	t.Run("closed", func(t *testing.T) {
+		set := open(t, t.TempDir(), durable.Options[int]{})
+		set.Add(1)
+
+		if err := set.Close(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if set.Add(2) || set.Remove(1) || set.Clear() != 0 || set.AddAll(cantor.NewHashSet(3).Elements()) != 0 {
+			t.Errorf("a closed set should not be modified")
+		}
+
+		if !set.Equals(cantor.NewHashSet(1)) || !set.SafeForConcurrentReads() {
+			t.Errorf("a closed set should still be readable, got %v", set)
+		}
+
+		for _, err := range []error{set.Err(), set.Sync(), set.Compact(), set.Close()} {
+			if !errors.Is(err, durable.ErrClosed) {
+				t.Errorf("expected %v, got %v", durable.ErrClosed, err)
+			}
+		}
+	})
+
+	t.Run("encoding", func(t *testing.T) {
+		set := open(t, t.TempDir(), durable.Options[float64]{Codec: durable.JSONCodec[float64]()})
+		set.Add(1)
+
+		if set.AddAll(cantor.NewHashSet(2, nan()).Elements()) != 0 || set.Size() != 1 {
+			t.Errorf("elements, which can not be encoded, should reject the change")
+		}
+
+		if set.Err() == nil || set.Add(3) {
+			t.Errorf("an encoding error should reject all further changes")
+		}
+	})
+
+	t.Run("encoding during compaction", func(t *testing.T) {
+		set := open(t, t.TempDir(), durable.Options[int]{Codec: &limitedCodec{limit: 1}})
+		set.Add(1)
+
+		if err := set.Compact(); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
+
+func TestCodecs(t *testing.T) {
+	jsonCodec := durable.JSONCodec[[]string]()
+
+	data, err := jsonCodec.Encode([]string{"a", "b"})
+	if err != nil || string(data) != `["a","b"]` {
+		t.Errorf("unexpected encoding: %s, %v", data, err)
+	}
+
+	decoded, err := jsonCodec.Decode(data)
+	if err != nil || len(decoded) != 2 || decoded[1] != "b" {
+		t.Errorf("unexpected decoding: %v, %v", decoded, err)
+	}
+
+	stringCodec := durable.StringCodec()
+
+	data, err = stringCodec.Encode("hello")
+	if err != nil || string(data) != "hello" {
+		t.Errorf("unexpected encoding: %s, %v", data, err)
+	}
+
+	text, err := stringCodec.Decode(data)
+	if err != nil || text != "hello" {
+		t.Errorf("unexpected decoding: %v, %v", text, err)
+	}
+}
@@ -0,0 +1,42 @@
+package durable_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/frederik-jatzkowski/cantor/durable"
+)
+
+// Durable sets survive restarts of the program.
+func ExampleDurableSet() {
+	dir, err := os.MkdirTemp("", "sessions")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	sessions, err := durable.Open(dir, durable.Options[string]{Codec: durable.StringCodec()})
+	if err != nil {
+		panic(err)
+	}
+
+	sessions.Add("alice")
+	sessions.Add("bob")
+	sessions.Remove("alice")
+
+	if err = sessions.Close(); err != nil {
+		panic(err)
+	}
+
+	restarted, err := durable.Open(dir, durable.Options[string]{Codec: durable.StringCodec()})
+	if err != nil {
+		panic(err)
+	}
+
+	defer restarted.Close()
+
+	fmt.Println(restarted)
+	// Output:
+	// {bob}
+}
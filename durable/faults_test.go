@@ -0,0 +1,168 @@
+package durable
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var errFault = errors.New("injected fault")
+
+// faultyFile fails the operation with the given name.
+type faultyFile struct {
+	file
+	failing string
+}
+
+func (f faultyFile) Write(data []byte) (int, error) {
+	if f.failing == "Write" {
+		return 0, errFault
+	}
+
+	return f.file.Write(data)
+}
+
+func (f faultyFile) Sync() error {
+	if f.failing == "Sync" {
+		return errFault
+	}
+
+	return f.file.Sync()
+}
+
+func (f faultyFile) Truncate(size int64) error {
+	if f.failing == "Truncate" {
+		return errFault
+	}
+
+	return f.file.Truncate(size)
+}
+
+func (f faultyFile) Close() error {
+	err := f.file.Close()
+	if f.failing == "Close" {
+		return errFault
+	}
+
+	return err
+}
+
+// injectFault lets the operation with the given name fail for the file at path.
+// The operation "Open" lets opening the file fail.
+func injectFault(t *testing.T, path string, operation string) {
+	t.Helper()
+
+	original := openFile
+	openFile = func(name string, flag int) (file, error) {
+		if name != path {
+			return original(name, flag)
+		}
+
+		if operation == "Open" {
+			return nil, errFault
+		}
+
+		opened, err := original(name, flag)
+		if err != nil {
+			return nil, err
+		}
+
+		return faultyFile{file: opened, failing: operation}, nil
+	}
+
+	t.Cleanup(func() {
+		openFile = original
+	})
+}
+
+func TestFaults(t *testing.T) {
+	add := func(set *DurableSet[int]) error {
+		if set.Add(1) {
+			t.Errorf("the set should not change, if the change could not be logged")
+		}
+
+		return set.Err()
+	}
+
+	for _, test := range []struct {
+		name      string
+		target    string
+		operation string
+		torn      bool
+		run       func(set *DurableSet[int]) error
+	}{
+		{name: "open log", target: logName, operation: "Open"},
+		{name: "truncate torn log", target: logName, operation: "Truncate", torn: true},
+		{name: "sync torn log", target: logName, operation: "Sync", torn: true},
+		{name: "write log", target: logName, operation: "Write", run: add},
+		{name: "sync log", target: logName, operation: "Sync", run: add},
+		{name: "sync log on close", target: logName, operation: "Sync", run: (*DurableSet[int]).Close},
+		{name: "close log", target: logName, operation: "Close", run: (*DurableSet[int]).Close},
+		{name: "truncate log on compaction", target: logName, operation: "Truncate", run: (*DurableSet[int]).Compact},
+		{name: "open snapshot", target: snapshotName + ".tmp", operation: "Open", run: (*DurableSet[int]).Compact},
+		{name: "write snapshot", target: snapshotName + ".tmp", operation: "Write", run: (*DurableSet[int]).Compact},
+		{name: "sync snapshot", target: snapshotName + ".tmp", operation: "Sync", run: (*DurableSet[int]).Compact},
+		{name: "close snapshot", target: snapshotName + ".tmp", operation: "Close", run: (*DurableSet[int]).Compact},
+		{name: "open directory", operation: "Open", run: (*DurableSet[int]).Compact},
+		{name: "sync directory", operation: "Sync", run: (*DurableSet[int]).Compact},
+		{name: "close directory", operation: "Close", run: (*DurableSet[int]).Compact},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if test.torn {
+				if err := os.WriteFile(filepath.Join(dir, logName), []byte{1}, 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			injectFault(t, filepath.Join(dir, test.target), test.operation)
+
+			set, err := Open(dir, Options[int]{})
+			if err == nil {
+				t.Cleanup(func() {
+					_ = set.Close()
+				})
+
+				err = test.run(set)
+			}
+
+			if !errors.Is(err, errFault) {
+				t.Errorf("expected %v, got %v", errFault, err)
+			}
+		})
+	}
+}
+
+func TestFaults_rejectedChange(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("sync log", func(t *testing.T) {
+		injectFault(t, filepath.Join(dir, logName), "Sync")
+
+		set, err := Open(dir, Options[int]{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// the record is written, but the change is rejected, since it could not be synced
+		if set.Add(1) || !errors.Is(set.Err(), errFault) {
+			t.Errorf("expected the change to be rejected but got %v", set.Err())
+		}
+
+		_ = set.Close()
+	})
+
+	set, err := Open(dir, Options[int]{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	defer set.Close()
+
+	if set.Contains(1) {
+		t.Errorf("expected the rejected change not to be replayed")
+	}
+}
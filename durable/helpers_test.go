@@ -0,0 +1,88 @@
+package durable_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/durable"
+)
+
+// write persists each batch of elements as a single record.
+func write(t *testing.T, dir string, batches ...[]string) {
+	t.Helper()
+
+	set := open(t, dir, durable.Options[string]{})
+	for _, batch := range batches {
+		set.AddAll(cantor.NewHashSet(batch...).Elements())
+	}
+
+	if err := set.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func compact(t *testing.T, dir string) {
+	t.Helper()
+
+	set := open(t, dir, durable.Options[string]{})
+	if err := set.Compact(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := set.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// damage replaces the content of the file at path with the result of modify.
+func damage(t *testing.T, path string, modify func(data []byte) []byte) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = os.WriteFile(path, modify(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// checksum fixes the header of a record, which is the only record in data.
+func checksum(data []byte) []byte {
+	table := crc32.MakeTable(crc32.Castagnoli)
+
+	binary.LittleEndian.PutUint32(data, uint32(len(data)-12))
+	binary.LittleEndian.PutUint32(data[4:], crc32.Checksum(data[:4], table))
+	binary.LittleEndian.PutUint32(data[8:], crc32.Update(crc32.Checksum(data[:4], table), table, data[12:]))
+
+	return data
+}
+
+func nan() float64 {
+	return math.NaN()
+}
+
+// limitedCodec fails to encode elements after it encoded limit elements.
+type limitedCodec struct {
+	limit int
+}
+
+func (codec *limitedCodec) Encode(element int) ([]byte, error) {
+	if codec.limit == 0 {
+		return nil, errors.New("limit exceeded")
+	}
+
+	codec.limit--
+
+	return durable.JSONCodec[int]().Encode(element)
+}
+
+func (codec *limitedCodec) Decode(data []byte) (int, error) {
+	return durable.JSONCodec[int]().Decode(data)
+}
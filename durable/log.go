@@ -0,0 +1,173 @@
+package durable
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	opAdd byte = iota + 1
+	opRemove
+	opClear
+)
+
+// recordHeaderSize is the size of the length and the checksums preceding the payload of each record.
+const recordHeaderSize = 12
+
+// snapshotChunkSize is the maximum number of elements per record of a snapshot.
+const snapshotChunkSize = 1024
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// file is the part of [os.File] used for writing. It allows tests to simulate failures.
+type file interface {
+	io.WriteCloser
+	Sync() error
+	Truncate(size int64) error
+}
+
+var openFile = func(name string, flag int) (file, error) {
+	return os.OpenFile(name, flag, 0o644)
+}
+
+// entry is a single change of a set.
+type entry struct {
+	op   byte
+	data []byte
+}
+
+// appendRecord appends a record containing the entries to buffer.
+// A record consists of the length of its payload, a CRC-32C checksum of the length, a CRC-32C checksum
+// of the length and the payload and the payload, which is a sequence of operations with length prefixed data.
+func appendRecord(buffer []byte, entries []entry) []byte {
+	start := len(buffer)
+	buffer = append(buffer, make([]byte, recordHeaderSize)...)
+
+	for _, entry := range entries {
+		buffer = append(buffer, entry.op)
+		buffer = appendUvarint(buffer, uint64(len(entry.data)))
+		buffer = append(buffer, entry.data...)
+	}
+
+	record := buffer[start:]
+	binary.LittleEndian.PutUint32(record, uint32(len(record)-recordHeaderSize))
+	binary.LittleEndian.PutUint32(record[4:], crc32.Checksum(record[:4], crcTable))
+	binary.LittleEndian.PutUint32(record[8:], recordChecksum(record))
+
+	return buffer
+}
+
+// recordChecksum returns the checksum of the length and the payload of a complete record.
+func recordChecksum(record []byte) uint32 {
+	return crc32.Update(crc32.Checksum(record[:4], crcTable), crcTable, record[recordHeaderSize:])
+}
+
+func appendUvarint(buffer []byte, value uint64) []byte {
+	var encoded [binary.MaxVarintLen64]byte
+
+	return append(buffer, encoded[:binary.PutUvarint(encoded[:], value)]...)
+}
+
+// readRecords calls apply for the entries of all complete records in data and returns the length of the valid prefix.
+// An incomplete or damaged last record is a torn write and ends the valid prefix.
+// Damaged records followed by further data are reported as [ErrCorrupted].
+func readRecords(data []byte, apply func(entry entry) error) (valid int, err error) {
+	for valid < len(data) {
+		record, torn, err := nextRecord(data[valid:])
+		if torn {
+			return valid, nil
+		}
+
+		if err == nil {
+			err = readEntries(record[recordHeaderSize:], apply)
+		}
+
+		if err != nil {
+			return valid, fmt.Errorf("%w at offset %d: %v", ErrCorrupted, valid, err)
+		}
+
+		valid += len(record)
+	}
+
+	return valid, nil
+}
+
+// nextRecord returns the record at the start of data or torn, if data only contains a partial append of a record.
+// Since the length has its own checksum, a damaged length is not mistaken for a record exceeding the data.
+func nextRecord(data []byte) (record []byte, torn bool, err error) {
+	if len(data) < recordHeaderSize {
+		return nil, true, nil
+	}
+
+	if crc32.Checksum(data[:4], crcTable) != binary.LittleEndian.Uint32(data[4:]) {
+		return nil, false, fmt.Errorf("length checksum mismatch")
+	}
+
+	length := recordHeaderSize + int(binary.LittleEndian.Uint32(data))
+	if length > len(data) {
+		return nil, true, nil
+	}
+
+	if recordChecksum(data[:length]) != binary.LittleEndian.Uint32(data[8:]) {
+		// only the last record can be damaged by a partial append
+		return nil, length == len(data), fmt.Errorf("checksum mismatch")
+	}
+
+	return data[:length], false, nil
+}
+
+func readEntries(payload []byte, apply func(entry entry) error) error {
+	for len(payload) > 0 {
+		op := payload[0]
+
+		length, n := binary.Uvarint(payload[1:])
+		if n <= 0 || length > uint64(len(payload)-1-n) {
+			return fmt.Errorf("invalid entry length")
+		}
+
+		err := apply(entry{op: op, data: payload[1+n : 1+n+int(length)]})
+		if err != nil {
+			return err
+		}
+
+		payload = payload[1+n+int(length):]
+	}
+
+	return nil
+}
+
+// writeFile atomically replaces the file at path with data.
+// The data is written to a temporary file, which is synced and renamed, before the directory is synced.
+func writeFile(dir, name string, data []byte) error {
+	path := filepath.Join(dir, name)
+	temporary := path + ".tmp"
+
+	tmp, err := openFile(temporary, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(temporary, path)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
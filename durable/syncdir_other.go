@@ -0,0 +1,22 @@
+//go:build !windows
+
+package durable
+
+import "os"
+
+// syncDir makes renames and newly created files in dir durable.
+func syncDir(dir string) error {
+	directory, err := openFile(dir, os.O_RDONLY)
+	if err != nil {
+		return err
+	}
+
+	err = directory.Sync()
+	closeErr := directory.Close()
+
+	if err != nil {
+		return err
+	}
+
+	return closeErr
+}
@@ -0,0 +1,188 @@
+package cantor
+
+import (
+	"fmt"
+	"strings"
+)
+
+// [Operation] is the kind of a node in a [Trace].
+type Operation int
+
+const (
+	// OperationContains is a container, which is not derived from other sets and was consulted directly.
+	OperationContains Operation = iota
+	// OperationUnion contains the elements of at least one operand.
+	OperationUnion
+	// OperationIntersection contains the elements of all operands.
+	OperationIntersection
+	// OperationDifference contains the elements of the first operand, which are not contained in any other operand.
+	OperationDifference
+	// OperationComplement contains all elements, which are not contained in its single operand.
+	OperationComplement
+)
+
+func (operation Operation) String() string {
+	switch operation {
+	case OperationUnion:
+		return "union"
+	case OperationIntersection:
+		return "intersection"
+	case OperationDifference:
+		return "difference"
+	case OperationComplement:
+		return "complement"
+	default:
+		return "contains"
+	}
+}
+
+// [Trace] describes how the membership of an element in a data view was decided.
+// It is returned by [Explain].
+type Trace[T comparable] struct {
+	// Operation is the kind of the consulted container.
+	Operation Operation
+	// Container is the consulted set or data view.
+	Container Container[T]
+	// Result is the result of Contains for the element. It is false, if the container was skipped.
+	Result bool
+	// Skipped is true, if the container was not consulted, because the result was already decided
+	// by previous operands. Skipped traces have no operands.
+	Skipped bool
+	// Operands contains the traces of the operands in the order they are consulted.
+	Operands []*Trace[T]
+}
+
+// [Explain] returns a [Trace], which shows how view.Contains(element) is decided.
+// The trace contains a node for each union, intersection, difference and complement created by this package
+// and a leaf for every other container, like a [HashSet] or an [ImplicitSet].
+// Operands, which are not consulted due to short-circuiting, are marked as skipped.
+//
+// Complements created by the Complement method are represented as leaves, since they are opaque [ImplicitSet]s.
+func Explain[T comparable](view Container[T], element T) *Trace[T] {
+	return explain(view, element, false)
+}
+
+func explain[T comparable](container Container[T], element T, skipped bool) *Trace[T] {
+	trace := &Trace[T]{Container: container, Skipped: skipped}
+
+	switch set := container.(type) {
+	case contextSet[T]:
+		return explain[T](set.set, element, skipped)
+	case union[T]:
+		trace.Operation = OperationUnion
+	case intersection[T]:
+		trace.Operation = OperationIntersection
+		if isDifference(set) {
+			trace.Operation = OperationDifference
+		}
+	case negation[T]:
+		trace.Operation = OperationComplement
+	}
+
+	if !skipped {
+		trace.consult(element)
+	}
+
+	return trace
+}
+
+// isDifference returns true, if all containers except the first one are negated.
+func isDifference[T comparable](set intersection[T]) bool {
+	for _, arg := range set.args {
+		if _, ok := arg.(negation[T]); !ok {
+			return false
+		}
+	}
+
+	return len(set.args) > 0
+}
+
+// consult sets the result and operands of the trace.
+func (trace *Trace[T]) consult(element T) {
+	switch set := trace.Container.(type) {
+	case union[T]:
+		for _, arg := range set.args {
+			trace.add(explain[T](arg, element, trace.Result))
+			trace.Result = trace.Result || trace.last().Result
+		}
+	case intersection[T]:
+		trace.consultIntersection(set, element)
+	case negation[T]:
+		trace.add(explain(set.arg, element, false))
+		trace.Result = !trace.last().Result
+	default:
+		trace.Result = set.Contains(element)
+	}
+}
+
+// consultIntersection consults the operands of an intersection or difference until the result is false.
+func (trace *Trace[T]) consultIntersection(set intersection[T], element T) {
+	difference := trace.Operation == OperationDifference
+
+	trace.add(explain[T](set.arg, element, false))
+	trace.Result = trace.last().Result
+
+	for _, arg := range set.args {
+		if difference {
+			arg = arg.(negation[T]).arg
+		}
+
+		trace.add(explain(arg, element, !trace.Result))
+		trace.Result = trace.Result && trace.last().Result != difference
+	}
+}
+
+func (trace *Trace[T]) add(operand *Trace[T]) {
+	trace.Operands = append(trace.Operands, operand)
+}
+
+func (trace *Trace[T]) last() *Trace[T] {
+	return trace.Operands[len(trace.Operands)-1]
+}
+
+// String formats the trace as an indented tree with one line per node, for example:
+//
+//	difference: false
+//	  union: true
+//	    cantor.HashSet[int](n=2): true
+//	    cantor.HashSet[int](n=1): skipped
+//	  cantor.HashSet[int](n=1): true
+//
+// Leaves are represented by their type and the number of their elements, if they are a [ReadableSet],
+// so that the trace stays short for large sets.
+func (trace *Trace[T]) String() string {
+	var builder strings.Builder
+
+	trace.format(&builder, 0)
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+func (trace *Trace[T]) format(builder *strings.Builder, depth int) {
+	builder.WriteString(strings.Repeat("  ", depth))
+
+	if trace.Operation != OperationContains {
+		builder.WriteString(trace.Operation.String())
+	} else {
+		trace.formatLeaf(builder)
+	}
+
+	if trace.Skipped {
+		builder.WriteString(": skipped\n")
+	} else {
+		fmt.Fprintf(builder, ": %v\n", trace.Result)
+	}
+
+	for _, operand := range trace.Operands {
+		operand.format(builder, depth+1)
+	}
+}
+
+// formatLeaf writes the type of the container and its size, if it is readable, instead of its elements.
+func (trace *Trace[T]) formatLeaf(builder *strings.Builder) {
+	fmt.Fprintf(builder, "%T", trace.Container)
+
+	if set, ok := trace.Container.(ReadableSet[T]); ok {
+		fmt.Fprintf(builder, "(n=%d)", set.Size())
+	}
+}
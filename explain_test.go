@@ -0,0 +1,169 @@
+package cantor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+func TestExplain(t *testing.T) {
+	a := cantor.NewHashSet(1)
+	b := cantor.NewHashSet(2)
+	c := cantor.NewHashSet(3)
+	even := cantor.NewImplicitSet(func(i int) bool { return i%2 == 0 })
+
+	tests := map[string]struct {
+		view     cantor.Container[int]
+		element  int
+		expected string
+	}{
+		"leaf": {
+			view:     a,
+			element:  1,
+			expected: "cantor.HashSet[int](n=1): true",
+		},
+		"implicit leaf": {
+			view:     even,
+			element:  1,
+			expected: "cantor.ImplicitSet[int]: false",
+		},
+		"union": {
+			view:    a.Union(b).Union(c),
+			element: 2,
+			expected: `union: true
+  cantor.HashSet[int](n=1): false
+  cantor.HashSet[int](n=1): true
+  cantor.HashSet[int](n=1): skipped`,
+		},
+		"intersection": {
+			view:    a.Intersect(b).Intersect(even),
+			element: 1,
+			expected: `intersection: false
+  cantor.HashSet[int](n=1): true
+  cantor.HashSet[int](n=1): false
+  cantor.ImplicitSet[int]: skipped`,
+		},
+		"difference": {
+			view:    a.Union(b).Difference(c).Difference(even),
+			element: 2,
+			expected: `difference: false
+  union: true
+    cantor.HashSet[int](n=1): false
+    cantor.HashSet[int](n=1): true
+  cantor.HashSet[int](n=1): false
+  cantor.ImplicitSet[int]: true`,
+		},
+		"skipped difference": {
+			view:    a.Union(b).Difference(c),
+			element: 3,
+			expected: `difference: false
+  union: false
+    cantor.HashSet[int](n=1): false
+    cantor.HashSet[int](n=1): false
+  cantor.HashSet[int](n=1): skipped`,
+		},
+		"complement in intersection": {
+			view:    a.Union(b).Difference(c).Intersect(even),
+			element: 2,
+			expected: `intersection: true
+  union: true
+    cantor.HashSet[int](n=1): false
+    cantor.HashSet[int](n=1): true
+  complement: true
+    cantor.HashSet[int](n=1): false
+  cantor.ImplicitSet[int]: true`,
+		},
+		"symmetric difference": {
+			view:    a.Union(b).SymmetricDifference(b),
+			element: 2,
+			expected: `union: false
+  difference: false
+    union: true
+      cantor.HashSet[int](n=1): false
+      cantor.HashSet[int](n=1): true
+    cantor.HashSet[int](n=1): true
+  difference: false
+    cantor.HashSet[int](n=1): true
+    union: true
+      cantor.HashSet[int](n=1): false
+      cantor.HashSet[int](n=1): true`,
+		},
+		"context": {
+			view:    cantor.WithContext(context.Background(), a.Union(b)),
+			element: 1,
+			expected: `union: true
+  cantor.HashSet[int](n=1): true
+  cantor.HashSet[int](n=1): skipped`,
+		},
+		"complement": {
+			view:     a.Complement(),
+			element:  1,
+			expected: "cantor.ImplicitSet[int]: false",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			trace := cantor.Explain(test.view, test.element)
+
+			if trace.String() != test.expected {
+				t.Errorf("expected\n%s\nbut got\n%s", test.expected, trace)
+			}
+
+			if trace.Result != test.view.Contains(test.element) {
+				t.Errorf("expected the result of the trace to match Contains")
+			}
+		})
+	}
+}
+
+func TestExplain_structure(t *testing.T) {
+	a := cantor.NewHashSet(1)
+	b := cantor.NewHashSet(2)
+	view := a.Union(b)
+
+	trace := cantor.Explain[int](view, 2)
+
+	if trace.Operation != cantor.OperationUnion || trace.Container.Contains(3) || len(trace.Operands) != 2 {
+		t.Fatalf("unexpected trace %v", trace)
+	}
+
+	if trace.Operands[0].Container.(cantor.HashSet[int]).Size() != 1 || trace.Operands[1].Skipped {
+		t.Errorf("unexpected operands")
+	}
+
+	operations := map[cantor.Operation]string{
+		cantor.OperationContains:     "contains",
+		cantor.OperationUnion:        "union",
+		cantor.OperationIntersection: "intersection",
+		cantor.OperationDifference:   "difference",
+		cantor.OperationComplement:   "complement",
+	}
+
+	for operation, expected := range operations {
+		if operation.String() != expected {
+			t.Errorf("expected %s but got %s", expected, operation)
+		}
+	}
+}
+
+// Traces answer questions like "why is user 3 not in the beta audience".
+func ExampleExplain() {
+	admins := cantor.NewHashSet(1, 2)
+	testers := cantor.NewHashSet(3)
+	optedOut := cantor.NewHashSet(3)
+
+	beta := admins.Union(testers).Difference(optedOut)
+
+	fmt.Println(cantor.Explain[int](beta, 3))
+	// Output:
+	// difference: false
+	//   union: true
+	//     cantor.HashSet[int](n=2): false
+	//     cantor.HashSet[int](n=1): true
+	//   cantor.HashSet[int](n=1): true
+}
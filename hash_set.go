@@ -55,6 +55,85 @@ func (set HashSet[T]) Remove(element T) (modified bool) {
 	return before > len(set)
 }
 
+// AddAll adds all elements of the iterator and returns the number of elements, which were actually added.
+//
+// Data views derived from this set will reflect the change.
+//
+// The time complexity of this method is O(n), where n is the number of elements of the iterator.
+func (set HashSet[T]) AddAll(iterator Iterator[T]) (modified int) {
+	before := len(set)
+
+	iterator(func(element T) bool {
+		set[element] = struct{}{}
+
+		return true
+	})
+
+	return len(set) - before
+}
+
+// RemoveAll removes all elements, which are contained in the other container,
+// and returns the number of elements, which were actually removed.
+// If the other container is a smaller [HashSet], its elements are removed instead of checking all elements of this set.
+//
+// Data views derived from this set will reflect the change.
+//
+// The time complexity of this method is O(min(n, m)) for a [HashSet] with m elements
+// and O(n) times the complexity of Contains of the other container otherwise.
+func (set HashSet[T]) RemoveAll(other Container[T]) (modified int) {
+	before := len(set)
+
+	hashSet, ok := other.(HashSet[T])
+	if ok && len(hashSet) < len(set) {
+		for element := range hashSet {
+			delete(set, element)
+		}
+
+		return before - len(set)
+	}
+
+	for element := range set {
+		if other.Contains(element) {
+			delete(set, element)
+		}
+	}
+
+	return before - len(set)
+}
+
+// RetainAll removes all elements, which are not contained in the other container,
+// and returns the number of elements, which were actually removed.
+//
+// Data views derived from this set will reflect the change.
+//
+// The time complexity of this method is O(n) times the complexity of Contains of the other container.
+func (set HashSet[T]) RetainAll(other Container[T]) (modified int) {
+	before := len(set)
+
+	for element := range set {
+		if !other.Contains(element) {
+			delete(set, element)
+		}
+	}
+
+	return before - len(set)
+}
+
+// Clear removes all elements and returns the number of elements, which were actually removed.
+//
+// Data views derived from this set will reflect the change.
+//
+// The time complexity of this method is O(n).
+func (set HashSet[T]) Clear() (modified int) {
+	before := len(set)
+
+	for element := range set {
+		delete(set, element)
+	}
+
+	return before
+}
+
 // Contains returns whether the element is contained in this [HashSet].
 //
 // The time complexity of this method is O(1).
@@ -93,9 +172,7 @@ func (set HashSet[T]) Complement() ImplicitSet[T] {
 //
 // The result is a data view and will reflect future changes of the underlying structures.
 func (set HashSet[T]) Difference(other Container[T]) ReadableSet[T] {
-	return set.Intersect(NewImplicitSet[T](func(element T) bool {
-		return !other.Contains(element)
-	}))
+	return set.Intersect(negation[T]{arg: other})
 }
 
 // SymmetricDifference returns a ReadableSet representing the set with all elements of this and the other set,
@@ -142,7 +219,14 @@ func (set HashSet[T]) Size() int {
 	return len(set)
 }
 
+// SafeForConcurrentReads implements [ConcurrentReader] and returns true,
+// since a [HashSet] can be read concurrently as long as it is not modified at the same time.
+func (set HashSet[T]) SafeForConcurrentReads() bool {
+	return true
+}
+
 // String implements [fmt.Stringer] for this [HashSet].
+// Elements, which could not be parsed back by [ParseHashSet] otherwise, are quoted.
 func (set HashSet[T]) String() string {
 	return toString[T](set)
 }
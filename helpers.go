@@ -9,7 +9,7 @@ func toString[T comparable](set ReadableSet[T]) string {
 	var elements []string
 
 	set.Elements()(func(element T) (next bool) {
-		elements = append(elements, fmt.Sprint(element))
+		elements = append(elements, quoteElement(fmt.Sprint(element)))
 
 		return true
 	})
@@ -26,3 +26,20 @@ func count[T comparable](iterator Iterator[T]) (result int) {
 
 	return result
 }
+
+// isEmpty returns true, if the set has no elements. A nil set is empty.
+func isEmpty[T comparable](set ReadableSet[T]) (empty bool) {
+	if set == nil {
+		return true
+	}
+
+	empty = true
+
+	set.Elements()(func(element T) (next bool) {
+		empty = false
+
+		return false
+	})
+
+	return empty
+}
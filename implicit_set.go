@@ -1,5 +1,7 @@
 package cantor
 
+import "reflect"
+
 // [ImplicitSet] represents a set, which is only defined by an arbitrary [Predicate].
 // Thus, an [ImplicitSet] can represent an infinite amount of elements without performance or memory overhead.
 // Due to its unconstrained nature, this type of set can only be used for lookups.
@@ -20,27 +22,27 @@ func (predicate ImplicitSet[T]) Contains(element T) bool {
 //
 // The result is a data view and will reflect future changes of the underlying structures.
 func (predicate ImplicitSet[T]) Union(other Container[T]) ImplicitSet[T] {
-	return func(element T) bool {
+	return derivedImplicitSet[T](func(element T) bool {
 		return predicate(element) || other.Contains(element)
-	}
+	}, predicate, other)
 }
 
 // Intersect returns an [ImplicitSet] set representing the set intersection of its arguments.
 //
 // The result is a data view and will reflect future changes of the underlying structures.
 func (predicate ImplicitSet[T]) Intersect(other Container[T]) ImplicitSet[T] {
-	return func(element T) bool {
+	return derivedImplicitSet[T](func(element T) bool {
 		return predicate(element) && other.Contains(element)
-	}
+	}, predicate, other)
 }
 
 // Complement returns an [ImplicitSet], that contains all elements not contained in this [ImplicitSet].
 //
 // The result is a data view and will reflect future changes of the underlying structures.
 func (predicate ImplicitSet[T]) Complement() ImplicitSet[T] {
-	return func(element T) bool {
+	return derivedImplicitSet[T](func(element T) bool {
 		return !predicate.Contains(element)
-	}
+	}, predicate)
 }
 
 // Difference returns an [ImplicitSet] set with all elements of this [ImplicitSet],
@@ -48,9 +50,9 @@ func (predicate ImplicitSet[T]) Complement() ImplicitSet[T] {
 //
 // The result is a data view and will reflect future changes of the underlying structures.
 func (predicate ImplicitSet[T]) Difference(other Container[T]) ImplicitSet[T] {
-	return func(element T) bool {
+	return derivedImplicitSet[T](func(element T) bool {
 		return predicate(element) && !other.Contains(element)
-	}
+	}, predicate, other)
 }
 
 // SymmetricDifference returns an [ImplicitSet] set with all elements of this [ImplicitSet] and the other [Container],
@@ -58,7 +60,85 @@ func (predicate ImplicitSet[T]) Difference(other Container[T]) ImplicitSet[T] {
 //
 // The result is a data view and will reflect future changes of the underlying structures.
 func (predicate ImplicitSet[T]) SymmetricDifference(other Container[T]) ImplicitSet[T] {
-	return func(element T) bool {
+	return derivedImplicitSet[T](func(element T) bool {
 		return predicate(element) != other.Contains(element)
+	}, predicate, other)
+}
+
+// Subset returns true, if all elements of this [ImplicitSet] within domain are contained in the other [Container].
+// If domain is nil, [ErrUndecidable] is returned.
+//
+// The time complexity of this method is linear in the size of domain.
+func (predicate ImplicitSet[T]) Subset(other Container[T], domain Domain[T]) (bool, error) {
+	return predicate.Difference(other).isEmptyIn(domain)
+}
+
+// StrictSubset returns true, if all elements of this [ImplicitSet] within domain are contained in the other [Container]
+// and the other [Container] contains at least one element of domain, which is not contained in this [ImplicitSet].
+// If domain is nil, [ErrUndecidable] is returned.
+//
+// The time complexity of this method is linear in the size of domain.
+func (predicate ImplicitSet[T]) StrictSubset(other Container[T], domain Domain[T]) (bool, error) {
+	subset, err := predicate.Subset(other, domain)
+	if err != nil || !subset {
+		return false, err
+	}
+
+	equal, err := predicate.Equals(other, domain)
+
+	return !equal, err
+}
+
+// Equals returns true, if this [ImplicitSet] and the other [Container] contain exactly the same elements of domain.
+// If domain is nil, [ErrUndecidable] is returned.
+//
+// The time complexity of this method is linear in the size of domain.
+func (predicate ImplicitSet[T]) Equals(other Container[T], domain Domain[T]) (bool, error) {
+	return predicate.SymmetricDifference(other).isEmptyIn(domain)
+}
+
+func (predicate ImplicitSet[T]) isEmptyIn(domain Domain[T]) (bool, error) {
+	if domain == nil {
+		return false, ErrUndecidable
+	}
+
+	empty := true
+
+	domain.Elements()(func(element T) (next bool) {
+		empty = !predicate(element)
+
+		return empty
+	})
+
+	return empty, nil
+}
+
+// SafeForConcurrentReads implements [ConcurrentReader] and returns true,
+// since the predicate of an [ImplicitSet] must be deterministic and must not create side effects.
+// Only sets derived from a [Container], which is not safe for concurrent reads, return false.
+func (predicate ImplicitSet[T]) SafeForConcurrentReads() bool {
+	return reflect.ValueOf(predicate).Pointer() != reflect.ValueOf(unsafeImplicitSet[T](nil)).Pointer()
+}
+
+// derivedImplicitSet returns an [ImplicitSet] of a predicate reading the given operands.
+// If one of them is not safe for concurrent reads, the result is marked by [unsafeImplicitSet].
+func derivedImplicitSet[T comparable](predicate Predicate[T], operands ...Container[T]) ImplicitSet[T] {
+	for _, operand := range operands {
+		if !safeForConcurrentReads[T](operand) {
+			return unsafeImplicitSet(predicate)
+		}
+	}
+
+	return ImplicitSet[T](predicate)
+}
+
+// unsafeImplicitSet wraps predicate in a closure, which is recognized by its code pointer,
+// so that [ImplicitSet] can remain a function type. It must not be inlined,
+// since inlining would create a separate closure with its own code pointer for every caller.
+//
+//go:noinline
+func unsafeImplicitSet[T comparable](predicate Predicate[T]) ImplicitSet[T] {
+	return func(element T) bool {
+		return predicate(element)
 	}
 }
@@ -1,6 +1,7 @@
 package cantor_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/frederik-jatzkowski/cantor"
@@ -361,3 +362,70 @@ func TestImplicitSet_SymmetricDifference(t *testing.T) {
 		}
 	})
 }
+
+func TestImplicitSet_comparisons(t *testing.T) {
+	allBytes := cantor.NewHashSet(testutils.AllBytes()...)
+	even := cantor.NewImplicitSet(func(element byte) bool {
+		return element%2 == 0
+	})
+	multipleOfFour := cantor.NewImplicitSet(func(element byte) bool {
+		return element%4 == 0
+	})
+	notOdd := cantor.NewImplicitSet(func(element byte) bool {
+		return element%2 != 1
+	})
+
+	tests := []struct {
+		name                         string
+		a                            cantor.ImplicitSet[byte]
+		b                            cantor.Container[byte]
+		domain                       cantor.Domain[byte]
+		subset, strictSubset, equals bool
+	}{
+		{"strict subset", multipleOfFour, even, allBytes, true, true, false},
+		{"superset", even, multipleOfFour, allBytes, false, false, false},
+		{"equal", even, notOdd, allBytes, true, false, true},
+		{"equal to readable set", even.Intersect(cantor.NewHashSet[byte](1, 2, 3, 4)),
+			cantor.NewHashSet[byte](2, 4), allBytes, true, false, true},
+		{"equal within domain", multipleOfFour, even, cantor.NewHashSet[byte](1, 4, 8), true, false, true},
+		{"empty domain", even, multipleOfFour, cantor.NewHashSet[byte](), true, false, true},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			subset, err := test.a.Subset(test.b, test.domain)
+			if err != nil || subset != test.subset {
+				t.Errorf("expected Subset to be %t but got %t (%v)", test.subset, subset, err)
+			}
+
+			strictSubset, err := test.a.StrictSubset(test.b, test.domain)
+			if err != nil || strictSubset != test.strictSubset {
+				t.Errorf("expected StrictSubset to be %t but got %t (%v)", test.strictSubset, strictSubset, err)
+			}
+
+			equals, err := test.a.Equals(test.b, test.domain)
+			if err != nil || equals != test.equals {
+				t.Errorf("expected Equals to be %t but got %t (%v)", test.equals, equals, err)
+			}
+		})
+	}
+
+	t.Run("undecidable", func(t *testing.T) {
+		_, err := even.Subset(multipleOfFour, nil)
+		if !errors.Is(err, cantor.ErrUndecidable) {
+			t.Errorf("expected ErrUndecidable for Subset but got %v", err)
+		}
+
+		_, err = multipleOfFour.StrictSubset(even, nil)
+		if !errors.Is(err, cantor.ErrUndecidable) {
+			t.Errorf("expected ErrUndecidable for StrictSubset but got %v", err)
+		}
+
+		_, err = even.Equals(notOdd, nil)
+		if !errors.Is(err, cantor.ErrUndecidable) {
+			t.Errorf("expected ErrUndecidable for Equals but got %v", err)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+// Package enums contains enum-like types with sets generated by cantor-gen.
+// It is used to test the generated code.
+package enums
+
+//go:generate go run ../../cmd/cantor-gen -type=Role,Level
+
+// Role is an example of an integer enum.
+type Role int
+
+// All roles.
+const (
+	Admin Role = iota
+	Editor
+	Viewer
+	Guest
+
+	// Owner is an alias of Admin.
+	Owner = Admin
+)
+
+// Level is an example of a string enum with more than 64 constants.
+type Level string
+
+// All levels.
+const (
+	Level00 Level = "level-00"
+	Level01 Level = "level-01"
+	Level02 Level = "level-02"
+	Level03 Level = "level-03"
+	Level04 Level = "level-04"
+	Level05 Level = "level-05"
+	Level06 Level = "level-06"
+	Level07 Level = "level-07"
+	Level08 Level = "level-08"
+	Level09 Level = "level-09"
+	Level10 Level = "level-10"
+	Level11 Level = "level-11"
+	Level12 Level = "level-12"
+	Level13 Level = "level-13"
+	Level14 Level = "level-14"
+	Level15 Level = "level-15"
+	Level16 Level = "level-16"
+	Level17 Level = "level-17"
+	Level18 Level = "level-18"
+	Level19 Level = "level-19"
+	Level20 Level = "level-20"
+	Level21 Level = "level-21"
+	Level22 Level = "level-22"
+	Level23 Level = "level-23"
+	Level24 Level = "level-24"
+	Level25 Level = "level-25"
+	Level26 Level = "level-26"
+	Level27 Level = "level-27"
+	Level28 Level = "level-28"
+	Level29 Level = "level-29"
+	Level30 Level = "level-30"
+	Level31 Level = "level-31"
+	Level32 Level = "level-32"
+	Level33 Level = "level-33"
+	Level34 Level = "level-34"
+	Level35 Level = "level-35"
+	Level36 Level = "level-36"
+	Level37 Level = "level-37"
+	Level38 Level = "level-38"
+	Level39 Level = "level-39"
+	Level40 Level = "level-40"
+	Level41 Level = "level-41"
+	Level42 Level = "level-42"
+	Level43 Level = "level-43"
+	Level44 Level = "level-44"
+	Level45 Level = "level-45"
+	Level46 Level = "level-46"
+	Level47 Level = "level-47"
+	Level48 Level = "level-48"
+	Level49 Level = "level-49"
+	Level50 Level = "level-50"
+	Level51 Level = "level-51"
+	Level52 Level = "level-52"
+	Level53 Level = "level-53"
+	Level54 Level = "level-54"
+	Level55 Level = "level-55"
+	Level56 Level = "level-56"
+	Level57 Level = "level-57"
+	Level58 Level = "level-58"
+	Level59 Level = "level-59"
+	Level60 Level = "level-60"
+	Level61 Level = "level-61"
+	Level62 Level = "level-62"
+	Level63 Level = "level-63"
+	Level64 Level = "level-64"
+	Level65 Level = "level-65"
+	Level66 Level = "level-66"
+	Level67 Level = "level-67"
+	Level68 Level = "level-68"
+	Level69 Level = "level-69"
+)
@@ -0,0 +1,818 @@
+// Code generated by "cantor-gen -type=Role,Level"; DO NOT EDIT.
+
+package enums
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+const roleSetWords = (4 + 63) / 64
+
+// RoleSet implements [cantor.Set] for Role using a bitmask with one bit for each constant of type Role.
+// Values of Role, which are not declared as constants, can not be added.
+//
+// The zero value is an empty set. RoleSet must be used by pointer.
+type RoleSet struct {
+	bits [roleSetWords]uint64
+}
+
+// RoleUniverse contains all constants of type Role.
+var RoleUniverse = cantor.NewUniverse(roleValues[:]...)
+
+var roleNames = [...]string{"Admin", "Editor", "Viewer", "Guest"}
+
+var roleValues = [...]Role{Admin, Editor, Viewer, Guest}
+
+func roleIndex(element Role) (int, bool) {
+	switch element {
+	case Admin:
+		return 0, true
+	case Editor:
+		return 1, true
+	case Viewer:
+		return 2, true
+	case Guest:
+		return 3, true
+	default:
+		return 0, false
+	}
+}
+
+func roleIndexByName(name string) (int, error) {
+	for i, candidate := range roleNames {
+		if candidate == name {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown Role %q", name)
+}
+
+// NewRoleSet returns a new RoleSet containing all given elements.
+// Elements, which are not declared as constants of type Role, are ignored.
+func NewRoleSet(elements ...Role) *RoleSet {
+	set := &RoleSet{}
+
+	for _, element := range elements {
+		set.Add(element)
+	}
+
+	return set
+}
+
+// ParseRoleSet parses a set literal like "{Admin}"
+// using the names of the constants of type Role.
+func ParseRoleSet(s string) (*RoleSet, error) {
+	indices, err := cantor.ParseHashSet(s, roleIndexByName)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &RoleSet{}
+
+	for index := range indices {
+		set.bits[index/64] |= 1 << (index % 64)
+	}
+
+	return set, nil
+}
+
+// Add adds element and returns true if this operation actually changed the set.
+// If the element was already contained or is not declared as constant of type Role,
+// this leaves the set unchanged and returns false.
+//
+// The time complexity of this method is O(1).
+func (set *RoleSet) Add(element Role) (modified bool) {
+	index, ok := roleIndex(element)
+	if !ok || set.bits[index/64]&(1<<(index%64)) != 0 {
+		return false
+	}
+
+	set.bits[index/64] |= 1 << (index % 64)
+
+	return true
+}
+
+// Remove removes element and returns true if this operation actually changed the set.
+//
+// The time complexity of this method is O(1).
+func (set *RoleSet) Remove(element Role) (modified bool) {
+	index, ok := roleIndex(element)
+	if !ok || set.bits[index/64]&(1<<(index%64)) == 0 {
+		return false
+	}
+
+	set.bits[index/64] &^= 1 << (index % 64)
+
+	return true
+}
+
+// AddAll adds all elements of the iterator and returns the number of elements, which were actually added.
+//
+// The time complexity of this method is O(n), where n is the number of elements of the iterator.
+func (set *RoleSet) AddAll(iterator cantor.Iterator[Role]) (modified int) {
+	iterator(func(element Role) bool {
+		if set.Add(element) {
+			modified++
+		}
+
+		return true
+	})
+
+	return modified
+}
+
+// RemoveAll removes all elements, which are contained in the other container,
+// and returns the number of elements, which were actually removed.
+// If the other container is a *RoleSet, this is computed word by word.
+func (set *RoleSet) RemoveAll(other cantor.Container[Role]) (modified int) {
+	before := set.Size()
+
+	if bitmask, ok := other.(*RoleSet); ok {
+		for i := range set.bits {
+			set.bits[i] &^= bitmask.bits[i]
+		}
+	} else {
+		set.filter(func(element Role) bool {
+			return !other.Contains(element)
+		})
+	}
+
+	return before - set.Size()
+}
+
+// RetainAll removes all elements, which are not contained in the other container,
+// and returns the number of elements, which were actually removed.
+// If the other container is a *RoleSet, this is computed word by word.
+func (set *RoleSet) RetainAll(other cantor.Container[Role]) (modified int) {
+	before := set.Size()
+
+	if bitmask, ok := other.(*RoleSet); ok {
+		for i := range set.bits {
+			set.bits[i] &= bitmask.bits[i]
+		}
+	} else {
+		set.filter(other.Contains)
+	}
+
+	return before - set.Size()
+}
+
+// Clear removes all elements and returns the number of elements, which were actually removed.
+//
+// The time complexity of this method is O(1).
+func (set *RoleSet) Clear() (modified int) {
+	modified = set.Size()
+	set.bits = [roleSetWords]uint64{}
+
+	return modified
+}
+
+// filter removes all elements, which should not be kept.
+func (set *RoleSet) filter(keep func(element Role) bool) {
+	for i := range set.bits {
+		for word := set.bits[i]; word != 0; word &= word - 1 {
+			if !keep(roleValues[i*64+bits.TrailingZeros64(word)]) {
+				set.bits[i] &^= word & -word
+			}
+		}
+	}
+}
+
+// Contains returns whether the element is contained in this set.
+//
+// The time complexity of this method is O(1).
+func (set *RoleSet) Contains(element Role) bool {
+	index, ok := roleIndex(element)
+
+	return ok && set.bits[index/64]&(1<<(index%64)) != 0
+}
+
+// Union returns a [cantor.ReadableSet] representing the set union of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *RoleSet) Union(other cantor.ReadableSet[Role]) cantor.ReadableSet[Role] {
+	return cantor.NewUnion[Role](set, other)
+}
+
+// Intersect returns a [cantor.ReadableSet] representing the set intersection of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *RoleSet) Intersect(other cantor.Container[Role]) cantor.ReadableSet[Role] {
+	return cantor.NewIntersection[Role](set, other)
+}
+
+// Complement returns a [cantor.ImplicitSet], representing all elements not contained in this set.
+// Use RoleUniverse.ComplementOf for an enumerable complement.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *RoleSet) Complement() cantor.ImplicitSet[Role] {
+	return cantor.NewImplicitSet(func(element Role) bool {
+		return !set.Contains(element)
+	})
+}
+
+// Difference returns a [cantor.ReadableSet] with all elements of this set, which are not contained in the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *RoleSet) Difference(other cantor.Container[Role]) cantor.ReadableSet[Role] {
+	return cantor.NewDifference[Role](set, other)
+}
+
+// SymmetricDifference returns a [cantor.ReadableSet] representing the set with all elements of this
+// and the other set, which are contained in exactly one of the two.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *RoleSet) SymmetricDifference(other cantor.ReadableSet[Role]) cantor.ReadableSet[Role] {
+	return set.Difference(other).Union(other.Difference(set))
+}
+
+// Equals returns true, if this set and the other set represent exactly the same elements.
+func (set *RoleSet) Equals(other cantor.ReadableSet[Role]) bool {
+	if other, ok := other.(*RoleSet); ok {
+		return set.bits == other.bits
+	}
+
+	return set.SymmetricDifference(other).Size() == 0
+}
+
+// Subset returns true, if all elements of this set are contained in the other container.
+func (set *RoleSet) Subset(other cantor.Container[Role]) bool {
+	if other, ok := other.(*RoleSet); ok {
+		for i := range set.bits {
+			if set.bits[i]&^other.bits[i] != 0 {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return set.Difference(other).Size() == 0
+}
+
+// StrictSubset returns true, if all elements of this set are contained in the other set
+// and the sets are not equal.
+func (set *RoleSet) StrictSubset(other cantor.ReadableSet[Role]) bool {
+	return set.Subset(other) && !set.Equals(other)
+}
+
+// Elements returns a [cantor.Iterator] over the elements of this set in the order of their declaration.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *RoleSet) Elements() cantor.Iterator[Role] {
+	return func(yield func(element Role) (next bool)) {
+		for i := range set.bits {
+			for word := set.bits[i]; word != 0; word &= word - 1 {
+				if !yield(roleValues[i*64+bits.TrailingZeros64(word)]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Size returns the number of elements in this set.
+//
+// The time complexity of this method is O(1).
+func (set *RoleSet) Size() int {
+	size := 0
+	for _, word := range set.bits {
+		size += bits.OnesCount64(word)
+	}
+
+	return size
+}
+
+// Bits implements [cantor.Bitmask], which allows computing similarities word by word.
+func (set *RoleSet) Bits() []uint64 {
+	return set.bits[:]
+}
+
+// String implements [fmt.Stringer] using the names of the constants, for example "{Admin}".
+func (set *RoleSet) String() string {
+	return "{" + strings.Join(set.names(), ", ") + "}"
+}
+
+// MarshalJSON implements [json.Marshaler] and encodes the set as array of the names of its elements.
+func (set *RoleSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(set.names())
+}
+
+// UnmarshalJSON implements [json.Unmarshaler] and decodes an array of names into the set.
+// The previous contents of the set are replaced.
+func (set *RoleSet) UnmarshalJSON(data []byte) error {
+	var names []string
+
+	err := json.Unmarshal(data, &names)
+	if err != nil {
+		return err
+	}
+
+	parsed := RoleSet{}
+
+	for _, name := range names {
+		index, err := roleIndexByName(name)
+		if err != nil {
+			return err
+		}
+
+		parsed.bits[index/64] |= 1 << (index % 64)
+	}
+
+	*set = parsed
+
+	return nil
+}
+
+// SafeForConcurrentReads implements [cantor.ConcurrentReader] and returns true,
+// since the set can be read concurrently as long as it is not modified at the same time.
+func (set *RoleSet) SafeForConcurrentReads() bool {
+	return true
+}
+
+func (set *RoleSet) names() []string {
+	names := []string{}
+
+	for i := range set.bits {
+		for word := set.bits[i]; word != 0; word &= word - 1 {
+			names = append(names, roleNames[i*64+bits.TrailingZeros64(word)])
+		}
+	}
+
+	return names
+}
+
+const levelSetWords = (70 + 63) / 64
+
+// LevelSet implements [cantor.Set] for Level using a bitmask with one bit for each constant of type Level.
+// Values of Level, which are not declared as constants, can not be added.
+//
+// The zero value is an empty set. LevelSet must be used by pointer.
+type LevelSet struct {
+	bits [levelSetWords]uint64
+}
+
+// LevelUniverse contains all constants of type Level.
+var LevelUniverse = cantor.NewUniverse(levelValues[:]...)
+
+var levelNames = [...]string{"Level00", "Level01", "Level02", "Level03", "Level04", "Level05", "Level06", "Level07", "Level08", "Level09", "Level10", "Level11", "Level12", "Level13", "Level14", "Level15", "Level16", "Level17", "Level18", "Level19", "Level20", "Level21", "Level22", "Level23", "Level24", "Level25", "Level26", "Level27", "Level28", "Level29", "Level30", "Level31", "Level32", "Level33", "Level34", "Level35", "Level36", "Level37", "Level38", "Level39", "Level40", "Level41", "Level42", "Level43", "Level44", "Level45", "Level46", "Level47", "Level48", "Level49", "Level50", "Level51", "Level52", "Level53", "Level54", "Level55", "Level56", "Level57", "Level58", "Level59", "Level60", "Level61", "Level62", "Level63", "Level64", "Level65", "Level66", "Level67", "Level68", "Level69"}
+
+var levelValues = [...]Level{Level00, Level01, Level02, Level03, Level04, Level05, Level06, Level07, Level08, Level09, Level10, Level11, Level12, Level13, Level14, Level15, Level16, Level17, Level18, Level19, Level20, Level21, Level22, Level23, Level24, Level25, Level26, Level27, Level28, Level29, Level30, Level31, Level32, Level33, Level34, Level35, Level36, Level37, Level38, Level39, Level40, Level41, Level42, Level43, Level44, Level45, Level46, Level47, Level48, Level49, Level50, Level51, Level52, Level53, Level54, Level55, Level56, Level57, Level58, Level59, Level60, Level61, Level62, Level63, Level64, Level65, Level66, Level67, Level68, Level69}
+
+func levelIndex(element Level) (int, bool) {
+	switch element {
+	case Level00:
+		return 0, true
+	case Level01:
+		return 1, true
+	case Level02:
+		return 2, true
+	case Level03:
+		return 3, true
+	case Level04:
+		return 4, true
+	case Level05:
+		return 5, true
+	case Level06:
+		return 6, true
+	case Level07:
+		return 7, true
+	case Level08:
+		return 8, true
+	case Level09:
+		return 9, true
+	case Level10:
+		return 10, true
+	case Level11:
+		return 11, true
+	case Level12:
+		return 12, true
+	case Level13:
+		return 13, true
+	case Level14:
+		return 14, true
+	case Level15:
+		return 15, true
+	case Level16:
+		return 16, true
+	case Level17:
+		return 17, true
+	case Level18:
+		return 18, true
+	case Level19:
+		return 19, true
+	case Level20:
+		return 20, true
+	case Level21:
+		return 21, true
+	case Level22:
+		return 22, true
+	case Level23:
+		return 23, true
+	case Level24:
+		return 24, true
+	case Level25:
+		return 25, true
+	case Level26:
+		return 26, true
+	case Level27:
+		return 27, true
+	case Level28:
+		return 28, true
+	case Level29:
+		return 29, true
+	case Level30:
+		return 30, true
+	case Level31:
+		return 31, true
+	case Level32:
+		return 32, true
+	case Level33:
+		return 33, true
+	case Level34:
+		return 34, true
+	case Level35:
+		return 35, true
+	case Level36:
+		return 36, true
+	case Level37:
+		return 37, true
+	case Level38:
+		return 38, true
+	case Level39:
+		return 39, true
+	case Level40:
+		return 40, true
+	case Level41:
+		return 41, true
+	case Level42:
+		return 42, true
+	case Level43:
+		return 43, true
+	case Level44:
+		return 44, true
+	case Level45:
+		return 45, true
+	case Level46:
+		return 46, true
+	case Level47:
+		return 47, true
+	case Level48:
+		return 48, true
+	case Level49:
+		return 49, true
+	case Level50:
+		return 50, true
+	case Level51:
+		return 51, true
+	case Level52:
+		return 52, true
+	case Level53:
+		return 53, true
+	case Level54:
+		return 54, true
+	case Level55:
+		return 55, true
+	case Level56:
+		return 56, true
+	case Level57:
+		return 57, true
+	case Level58:
+		return 58, true
+	case Level59:
+		return 59, true
+	case Level60:
+		return 60, true
+	case Level61:
+		return 61, true
+	case Level62:
+		return 62, true
+	case Level63:
+		return 63, true
+	case Level64:
+		return 64, true
+	case Level65:
+		return 65, true
+	case Level66:
+		return 66, true
+	case Level67:
+		return 67, true
+	case Level68:
+		return 68, true
+	case Level69:
+		return 69, true
+	default:
+		return 0, false
+	}
+}
+
+func levelIndexByName(name string) (int, error) {
+	for i, candidate := range levelNames {
+		if candidate == name {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown Level %q", name)
+}
+
+// NewLevelSet returns a new LevelSet containing all given elements.
+// Elements, which are not declared as constants of type Level, are ignored.
+func NewLevelSet(elements ...Level) *LevelSet {
+	set := &LevelSet{}
+
+	for _, element := range elements {
+		set.Add(element)
+	}
+
+	return set
+}
+
+// ParseLevelSet parses a set literal like "{Level00}"
+// using the names of the constants of type Level.
+func ParseLevelSet(s string) (*LevelSet, error) {
+	indices, err := cantor.ParseHashSet(s, levelIndexByName)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &LevelSet{}
+
+	for index := range indices {
+		set.bits[index/64] |= 1 << (index % 64)
+	}
+
+	return set, nil
+}
+
+// Add adds element and returns true if this operation actually changed the set.
+// If the element was already contained or is not declared as constant of type Level,
+// this leaves the set unchanged and returns false.
+//
+// The time complexity of this method is O(1).
+func (set *LevelSet) Add(element Level) (modified bool) {
+	index, ok := levelIndex(element)
+	if !ok || set.bits[index/64]&(1<<(index%64)) != 0 {
+		return false
+	}
+
+	set.bits[index/64] |= 1 << (index % 64)
+
+	return true
+}
+
+// Remove removes element and returns true if this operation actually changed the set.
+//
+// The time complexity of this method is O(1).
+func (set *LevelSet) Remove(element Level) (modified bool) {
+	index, ok := levelIndex(element)
+	if !ok || set.bits[index/64]&(1<<(index%64)) == 0 {
+		return false
+	}
+
+	set.bits[index/64] &^= 1 << (index % 64)
+
+	return true
+}
+
+// AddAll adds all elements of the iterator and returns the number of elements, which were actually added.
+//
+// The time complexity of this method is O(n), where n is the number of elements of the iterator.
+func (set *LevelSet) AddAll(iterator cantor.Iterator[Level]) (modified int) {
+	iterator(func(element Level) bool {
+		if set.Add(element) {
+			modified++
+		}
+
+		return true
+	})
+
+	return modified
+}
+
+// RemoveAll removes all elements, which are contained in the other container,
+// and returns the number of elements, which were actually removed.
+// If the other container is a *LevelSet, this is computed word by word.
+func (set *LevelSet) RemoveAll(other cantor.Container[Level]) (modified int) {
+	before := set.Size()
+
+	if bitmask, ok := other.(*LevelSet); ok {
+		for i := range set.bits {
+			set.bits[i] &^= bitmask.bits[i]
+		}
+	} else {
+		set.filter(func(element Level) bool {
+			return !other.Contains(element)
+		})
+	}
+
+	return before - set.Size()
+}
+
+// RetainAll removes all elements, which are not contained in the other container,
+// and returns the number of elements, which were actually removed.
+// If the other container is a *LevelSet, this is computed word by word.
+func (set *LevelSet) RetainAll(other cantor.Container[Level]) (modified int) {
+	before := set.Size()
+
+	if bitmask, ok := other.(*LevelSet); ok {
+		for i := range set.bits {
+			set.bits[i] &= bitmask.bits[i]
+		}
+	} else {
+		set.filter(other.Contains)
+	}
+
+	return before - set.Size()
+}
+
+// Clear removes all elements and returns the number of elements, which were actually removed.
+//
+// The time complexity of this method is O(1).
+func (set *LevelSet) Clear() (modified int) {
+	modified = set.Size()
+	set.bits = [levelSetWords]uint64{}
+
+	return modified
+}
+
+// filter removes all elements, which should not be kept.
+func (set *LevelSet) filter(keep func(element Level) bool) {
+	for i := range set.bits {
+		for word := set.bits[i]; word != 0; word &= word - 1 {
+			if !keep(levelValues[i*64+bits.TrailingZeros64(word)]) {
+				set.bits[i] &^= word & -word
+			}
+		}
+	}
+}
+
+// Contains returns whether the element is contained in this set.
+//
+// The time complexity of this method is O(1).
+func (set *LevelSet) Contains(element Level) bool {
+	index, ok := levelIndex(element)
+
+	return ok && set.bits[index/64]&(1<<(index%64)) != 0
+}
+
+// Union returns a [cantor.ReadableSet] representing the set union of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *LevelSet) Union(other cantor.ReadableSet[Level]) cantor.ReadableSet[Level] {
+	return cantor.NewUnion[Level](set, other)
+}
+
+// Intersect returns a [cantor.ReadableSet] representing the set intersection of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *LevelSet) Intersect(other cantor.Container[Level]) cantor.ReadableSet[Level] {
+	return cantor.NewIntersection[Level](set, other)
+}
+
+// Complement returns a [cantor.ImplicitSet], representing all elements not contained in this set.
+// Use LevelUniverse.ComplementOf for an enumerable complement.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *LevelSet) Complement() cantor.ImplicitSet[Level] {
+	return cantor.NewImplicitSet(func(element Level) bool {
+		return !set.Contains(element)
+	})
+}
+
+// Difference returns a [cantor.ReadableSet] with all elements of this set, which are not contained in the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *LevelSet) Difference(other cantor.Container[Level]) cantor.ReadableSet[Level] {
+	return cantor.NewDifference[Level](set, other)
+}
+
+// SymmetricDifference returns a [cantor.ReadableSet] representing the set with all elements of this
+// and the other set, which are contained in exactly one of the two.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *LevelSet) SymmetricDifference(other cantor.ReadableSet[Level]) cantor.ReadableSet[Level] {
+	return set.Difference(other).Union(other.Difference(set))
+}
+
+// Equals returns true, if this set and the other set represent exactly the same elements.
+func (set *LevelSet) Equals(other cantor.ReadableSet[Level]) bool {
+	if other, ok := other.(*LevelSet); ok {
+		return set.bits == other.bits
+	}
+
+	return set.SymmetricDifference(other).Size() == 0
+}
+
+// Subset returns true, if all elements of this set are contained in the other container.
+func (set *LevelSet) Subset(other cantor.Container[Level]) bool {
+	if other, ok := other.(*LevelSet); ok {
+		for i := range set.bits {
+			if set.bits[i]&^other.bits[i] != 0 {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return set.Difference(other).Size() == 0
+}
+
+// StrictSubset returns true, if all elements of this set are contained in the other set
+// and the sets are not equal.
+func (set *LevelSet) StrictSubset(other cantor.ReadableSet[Level]) bool {
+	return set.Subset(other) && !set.Equals(other)
+}
+
+// Elements returns a [cantor.Iterator] over the elements of this set in the order of their declaration.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *LevelSet) Elements() cantor.Iterator[Level] {
+	return func(yield func(element Level) (next bool)) {
+		for i := range set.bits {
+			for word := set.bits[i]; word != 0; word &= word - 1 {
+				if !yield(levelValues[i*64+bits.TrailingZeros64(word)]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Size returns the number of elements in this set.
+//
+// The time complexity of this method is O(1).
+func (set *LevelSet) Size() int {
+	size := 0
+	for _, word := range set.bits {
+		size += bits.OnesCount64(word)
+	}
+
+	return size
+}
+
+// Bits implements [cantor.Bitmask], which allows computing similarities word by word.
+func (set *LevelSet) Bits() []uint64 {
+	return set.bits[:]
+}
+
+// String implements [fmt.Stringer] using the names of the constants, for example "{Level00}".
+func (set *LevelSet) String() string {
+	return "{" + strings.Join(set.names(), ", ") + "}"
+}
+
+// MarshalJSON implements [json.Marshaler] and encodes the set as array of the names of its elements.
+func (set *LevelSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(set.names())
+}
+
+// UnmarshalJSON implements [json.Unmarshaler] and decodes an array of names into the set.
+// The previous contents of the set are replaced.
+func (set *LevelSet) UnmarshalJSON(data []byte) error {
+	var names []string
+
+	err := json.Unmarshal(data, &names)
+	if err != nil {
+		return err
+	}
+
+	parsed := LevelSet{}
+
+	for _, name := range names {
+		index, err := levelIndexByName(name)
+		if err != nil {
+			return err
+		}
+
+		parsed.bits[index/64] |= 1 << (index % 64)
+	}
+
+	*set = parsed
+
+	return nil
+}
+
+// SafeForConcurrentReads implements [cantor.ConcurrentReader] and returns true,
+// since the set can be read concurrently as long as it is not modified at the same time.
+func (set *LevelSet) SafeForConcurrentReads() bool {
+	return true
+}
+
+func (set *LevelSet) names() []string {
+	names := []string{}
+
+	for i := range set.bits {
+		for word := set.bits[i]; word != 0; word &= word - 1 {
+			names = append(names, levelNames[i*64+bits.TrailingZeros64(word)])
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,236 @@
+package enums_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/enums"
+)
+
+// generatedSet is the API of all sets generated by cantor-gen.
+type generatedSet[T comparable] interface {
+	cantor.BulkSet[T]
+	json.Marshaler
+	json.Unmarshaler
+}
+
+// generated describes the code generated for an enum type.
+type generated[T comparable, S generatedSet[T]] struct {
+	newSet   func(elements ...T) S
+	parse    func(s string) (S, error)
+	universe cantor.Universe[T]
+	// values holds four distinct constants in the order of their declaration and their names.
+	values  [4]T
+	names   [4]string
+	invalid T
+}
+
+func TestRoleSet(t *testing.T) {
+	testGeneratedSet(t, generated[enums.Role, *enums.RoleSet]{
+		newSet:   enums.NewRoleSet,
+		parse:    enums.ParseRoleSet,
+		universe: enums.RoleUniverse,
+		values:   [4]enums.Role{enums.Admin, enums.Editor, enums.Viewer, enums.Guest},
+		names:    [4]string{"Admin", "Editor", "Viewer", "Guest"},
+		invalid:  enums.Role(42),
+	})
+
+	t.Run("alias", func(t *testing.T) {
+		set := enums.NewRoleSet(enums.Owner)
+
+		if !set.Contains(enums.Admin) || set.Add(enums.Admin) || set.String() != "{Admin}" {
+			t.Errorf("expected Owner to be the same element as Admin but got %v", set)
+		}
+	})
+}
+
+func TestLevelSet(t *testing.T) {
+	testGeneratedSet(t, generated[enums.Level, *enums.LevelSet]{
+		newSet:   enums.NewLevelSet,
+		parse:    enums.ParseLevelSet,
+		universe: enums.LevelUniverse,
+		values:   [4]enums.Level{enums.Level00, enums.Level63, enums.Level64, enums.Level69},
+		names:    [4]string{"Level00", "Level63", "Level64", "Level69"},
+		invalid:  enums.Level("x"),
+	})
+}
+
+func testGeneratedSet[T comparable, S generatedSet[T]](t *testing.T, g generated[T, S]) {
+	a, b, c, d := g.values[0], g.values[1], g.values[2], g.values[3]
+
+	t.Run("Add", func(t *testing.T) {
+		set := g.newSet(a, a, g.invalid)
+
+		if set.Size() != 1 || !set.Contains(a) || set.Contains(g.invalid) {
+			t.Errorf("expected {%v} but got %v", a, set)
+		}
+
+		if set.Add(a) || !set.Add(c) || set.Add(g.invalid) {
+			t.Errorf("unexpected results of Add")
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		set := g.newSet(a, b)
+
+		if !set.Remove(a) || set.Remove(a) || set.Remove(g.invalid) {
+			t.Errorf("unexpected results of Remove")
+		}
+
+		if set.String() != "{"+g.names[1]+"}" {
+			t.Errorf("expected {%s} but got %v", g.names[1], set)
+		}
+	})
+
+	t.Run("Elements", func(t *testing.T) {
+		set := g.newSet(d, a, c)
+
+		var elements []T
+
+		set.Elements()(func(element T) (next bool) {
+			elements = append(elements, element)
+
+			return len(elements) < 2
+		})
+
+		if len(elements) != 2 || elements[0] != a || elements[1] != c {
+			t.Errorf("expected [%v %v] in order of declaration but got %v", a, c, elements)
+		}
+	})
+
+	t.Run("views", func(t *testing.T) {
+		x := g.newSet(a, b)
+		y := g.newSet(b, c)
+
+		union := x.Union(y)
+		intersection := x.Intersect(y)
+		difference := x.Difference(y)
+		symmetricDifference := x.SymmetricDifference(y)
+		complement := g.universe.ComplementOf(x)
+		implicitComplement := x.Complement()
+
+		x.Add(d)
+
+		tests := []struct {
+			view     cantor.ReadableSet[T]
+			expected S
+		}{
+			{union, g.newSet(a, b, c, d)},
+			{intersection, g.newSet(b)},
+			{difference, g.newSet(a, d)},
+			{symmetricDifference, g.newSet(a, c, d)},
+		}
+
+		for _, test := range tests {
+			if !test.expected.Equals(test.view) || !test.view.Equals(test.expected) {
+				t.Errorf("expected %v but got %v", test.expected, test.view)
+			}
+		}
+
+		if complement.Contains(a) || !complement.Contains(c) || complement.Size() != g.universe.Size()-3 {
+			t.Errorf("unexpected complement %v", complement)
+		}
+
+		if implicitComplement.Contains(a) || !implicitComplement.Contains(c) {
+			t.Errorf("unexpected implicit complement")
+		}
+	})
+
+	t.Run("comparisons", func(t *testing.T) {
+		x := g.newSet(a)
+		y := g.newSet(a, b)
+
+		switch {
+		case !x.Subset(y), y.Subset(x), !x.Subset(y.Union(x)), y.Subset(x.Union(x)):
+			t.Errorf("unexpected results of Subset")
+		case !x.StrictSubset(y), y.StrictSubset(x), x.StrictSubset(x):
+			t.Errorf("unexpected results of StrictSubset")
+		case x.Equals(y), !x.Equals(g.newSet(a)), x.Equals(y.Union(x)):
+			t.Errorf("unexpected results of Equals")
+		}
+	})
+
+	t.Run("bulk", func(t *testing.T) {
+		set := g.newSet(a)
+
+		if modified := set.AddAll(cantor.NewHashSet(a, b, c, g.invalid).Elements()); modified != 2 {
+			t.Errorf("expected 2 added elements but got %d", modified)
+		}
+
+		for _, other := range []cantor.Container[T]{g.newSet(b, d), cantor.NewHashSet(b, d)} {
+			removed := g.newSet(a, b, c)
+			retained := g.newSet(a, b, c)
+
+			if modified := removed.RemoveAll(other); modified != 1 || !removed.Equals(g.newSet(a, c)) {
+				t.Errorf("expected {%v, %v} but got %v and %d", a, c, removed, modified)
+			}
+
+			if modified := retained.RetainAll(other); modified != 2 || !retained.Equals(g.newSet(b)) {
+				t.Errorf("expected {%v} but got %v and %d", b, retained, modified)
+			}
+		}
+
+		if modified := set.Clear(); modified != 3 || set.Size() != 0 {
+			t.Errorf("expected an empty set but got %v and %d", set, modified)
+		}
+	})
+
+	t.Run("similarities", func(t *testing.T) {
+		x := g.newSet(a, c)
+		y := g.newSet(c, d)
+		view := cantor.NewHashSet(c, d)
+
+		switch {
+		case cantor.Hamming[T](x, y) != 2, cantor.Hamming[T](x, view) != 2:
+			t.Errorf("unexpected results of Hamming")
+		case cantor.Jaccard[T](x, y) != 1.0/3, cantor.Jaccard[T](x, view) != 1.0/3:
+			t.Errorf("unexpected results of Jaccard")
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		expected := "{" + g.names[0] + ", " + g.names[2] + "}"
+
+		set, err := g.parse(g.newSet(c, a).String())
+		if err != nil || set.String() != expected {
+			t.Errorf("expected %s but got %v (%v)", expected, set, err)
+		}
+
+		for _, invalid := range []string{"{" + g.names[0] + ", unknown}", "{"} {
+			_, err = g.parse(invalid)
+			if err == nil {
+				t.Errorf("expected an error for %s", invalid)
+			}
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		expected := `["` + g.names[0] + `","` + g.names[2] + `"]`
+
+		data, err := json.Marshal(g.newSet(c, a))
+		if err != nil || string(data) != expected {
+			t.Errorf("expected %s but got %s (%v)", expected, data, err)
+		}
+
+		set := g.newSet(d)
+
+		err = json.Unmarshal([]byte(`["`+g.names[1]+`"]`), set)
+		if err != nil || !set.Equals(g.newSet(b)) {
+			t.Errorf("expected {%s} but got %v (%v)", g.names[1], set, err)
+		}
+
+		for _, invalid := range []string{`["unknown"]`, `{}`} {
+			if json.Unmarshal([]byte(invalid), set) == nil || !set.Equals(g.newSet(b)) {
+				t.Errorf("expected an error for %s without modification", invalid)
+			}
+		}
+	})
+
+	t.Run("parallel", func(t *testing.T) {
+		size, err := cantor.ParallelSize(g.newSet(a).Union(g.newSet(d)), 2)
+		if err != nil || size != 2 {
+			t.Errorf("expected 2 but got %d (%v)", size, err)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package sets
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/frederik-jatzkowski/cantor"
@@ -35,5 +36,20 @@ func RunTestsForReadableSet(t *testing.T, constructor Constructor[byte, cantor.R
 				t.Errorf("invalid string: %s", str)
 			}
 		})
+
+		t.Run("json.Marshaler", func(t *testing.T) {
+			set := constructor(1, 2)
+
+			data, err := json.Marshal(set)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			switch string(data) {
+			case "[1,2]", "[2,1]":
+			default:
+				t.Errorf("invalid json: %s", data)
+			}
+		})
 	})
 }
@@ -132,5 +132,7 @@ func RunTestsForSet(t *testing.T, constructor Constructor[byte, cantor.Set[byte]
 				}
 			})
 		})
+
+		RunTestsForSet_Bulk(t, constructor)
 	})
 }
@@ -0,0 +1,83 @@
+package sets
+
+import (
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/testutils"
+)
+
+// RunTestsForSet_Bulk runs a test suite to check the bulk operations
+// [pkg/github.com/frederik-jatzkowski/cantor.AddAll], [pkg/github.com/frederik-jatzkowski/cantor.RemoveAll],
+// [pkg/github.com/frederik-jatzkowski/cantor.RetainAll] and [pkg/github.com/frederik-jatzkowski/cantor.Clear]
+// on a [pkg/github.com/frederik-jatzkowski/cantor.Set], which may implement
+// [pkg/github.com/frederik-jatzkowski/cantor.BulkSet].
+func RunTestsForSet_Bulk(t *testing.T, constructor Constructor[byte, cantor.Set[byte]]) {
+	others := map[string]func(elements ...byte) cantor.Container[byte]{
+		"same type": func(elements ...byte) cantor.Container[byte] {
+			return constructor(elements...)
+		},
+		"hash set": func(elements ...byte) cantor.Container[byte] {
+			return cantor.NewHashSet(elements...)
+		},
+		"implicit set": func(elements ...byte) cantor.Container[byte] {
+			return cantor.NewImplicitSet(func(element byte) bool {
+				return testutils.SliceContains(element, elements)
+			})
+		},
+	}
+
+	t.Run("AddAll", func(t *testing.T) {
+		set := constructor(1, 2)
+
+		modified := cantor.AddAll(set, cantor.NewHashSet[byte](2, 3, 4).Elements())
+		if modified != 2 || !set.Equals(cantor.NewHashSet[byte](1, 2, 3, 4)) {
+			t.Errorf("expected {1, 2, 3, 4} and 2 changes but got %v and %d", set, modified)
+		}
+
+		modified = cantor.AddAll(set, set.Elements())
+		if modified != 0 || set.Size() != 4 {
+			t.Errorf("expected no changes when adding its own elements but got %v and %d", set, modified)
+		}
+	})
+
+	for name, other := range others {
+		other := other
+
+		t.Run("RemoveAll/"+name, func(t *testing.T) {
+			// the argument is smaller or larger than the set
+			for _, elements := range [][]byte{{2, 3, 9}, {1, 2, 3, 4, 5, 9}} {
+				set := constructor(1, 2, 3, 4)
+				expected := cantor.NewHashSet[byte](1, 2, 3, 4).Difference(cantor.NewHashSet(elements...))
+				expectedModified := 4 - expected.Size()
+
+				modified := cantor.RemoveAll(set, other(elements...))
+				if modified != expectedModified || !set.Equals(expected) {
+					t.Errorf("expected %v and %d changes but got %v and %d", expected, expectedModified, set, modified)
+				}
+			}
+		})
+
+		t.Run("RetainAll/"+name, func(t *testing.T) {
+			set := constructor(1, 2, 3, 4)
+
+			modified := cantor.RetainAll(set, other(2, 3, 9))
+			if modified != 2 || !set.Equals(cantor.NewHashSet[byte](2, 3)) {
+				t.Errorf("expected {2, 3} and 2 changes but got %v and %d", set, modified)
+			}
+		})
+	}
+
+	t.Run("Clear", func(t *testing.T) {
+		set := constructor(testutils.AllBytes()...)
+
+		modified := cantor.Clear(set)
+		if modified != len(testutils.AllBytes()) || set.Size() != 0 {
+			t.Errorf("expected an empty set but got %v and %d", set, modified)
+		}
+
+		if cantor.Clear(set) != 0 {
+			t.Errorf("expected no changes when clearing an empty set")
+		}
+	})
+}
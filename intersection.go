@@ -5,6 +5,14 @@ type intersection[T comparable] struct {
 	args []Container[T]
 }
 
+// [NewIntersection] returns a [ReadableSet] representing the set intersection of all arguments.
+// It can be used to implement [ReadableSet] outside of this package.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func NewIntersection[T comparable](arg ReadableSet[T], args ...Container[T]) ReadableSet[T] {
+	return newIntersection(arg, args...)
+}
+
 func newIntersection[T comparable](arg ReadableSet[T], args ...Container[T]) ReadableSet[T] {
 	return intersection[T]{
 		arg:  arg,
@@ -35,15 +43,13 @@ func (set intersection[T]) Intersect(other Container[T]) ReadableSet[T] {
 }
 
 func (set intersection[T]) Complement() ImplicitSet[T] {
-	return NewImplicitSet(func(element T) bool {
+	return derivedImplicitSet[T](func(element T) bool {
 		return !set.Contains(element)
-	})
+	}, set)
 }
 
 func (set intersection[T]) Difference(other Container[T]) ReadableSet[T] {
-	return set.Intersect(NewImplicitSet[T](func(element T) bool {
-		return !other.Contains(element)
-	}))
+	return set.Intersect(negation[T]{arg: other})
 }
 
 func (set intersection[T]) SymmetricDifference(other ReadableSet[T]) ReadableSet[T] {
@@ -83,3 +89,21 @@ func (set intersection[T]) String() string {
 func (set intersection[T]) Size() int {
 	return count(set.Elements())
 }
+
+func (set intersection[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](set)
+}
+
+func (set intersection[T]) SafeForConcurrentReads() bool {
+	if !safeForConcurrentReads[T](set.arg) {
+		return false
+	}
+
+	for _, arg := range set.args {
+		if !safeForConcurrentReads[T](arg) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -15,3 +15,28 @@ func Test_intersection_ReadableSet(t *testing.T) {
 		return a.Intersect(b)
 	})
 }
+
+func TestNewIntersection_ReadableSet(t *testing.T) {
+	sets.RunTestsForReadableSet(t, func(elements ...byte) cantor.ReadableSet[byte] {
+		a := cantor.NewHashSet(elements...)
+		b := cantor.NewHashSet(elements...)
+
+		return cantor.NewIntersection[byte](a, b)
+	})
+}
+
+func TestNewDifference_ReadableSet(t *testing.T) {
+	sets.RunTestsForReadableSet(t, func(elements ...byte) cantor.ReadableSet[byte] {
+		a := cantor.NewHashSet(elements...)
+		b := cantor.NewHashSet[byte](0, 255)
+
+		a.Add(0)
+		a.Add(255)
+
+		for _, element := range elements {
+			b.Remove(element)
+		}
+
+		return cantor.NewDifference[byte](a, b)
+	})
+}
@@ -0,0 +1,64 @@
+package iterators
+
+import "github.com/frederik-jatzkowski/cantor"
+
+// [Reduce] combines all elements of the iterator into a single value, starting with initial.
+// The reducer is called once for each element with the accumulated value so far.
+func Reduce[T, A any](iterator cantor.Iterator[T], initial A, reducer func(accumulator A, element T) A) A {
+	result := initial
+
+	iterator(func(element T) (next bool) {
+		result = reducer(result, element)
+
+		return true
+	})
+
+	return result
+}
+
+// [Any] returns true, if the [cantor.Predicate] is true for at least one element of the iterator.
+// Iteration is stopped at the first match.
+func Any[T any](iterator cantor.Iterator[T], predicate cantor.Predicate[T]) bool {
+	found := false
+
+	iterator(func(element T) (next bool) {
+		found = predicate(element)
+
+		return !found
+	})
+
+	return found
+}
+
+// [All] returns true, if the [cantor.Predicate] is true for all elements of the iterator.
+// Iteration is stopped at the first mismatch. For an empty iterator, the result is true.
+func All[T any](iterator cantor.Iterator[T], predicate cantor.Predicate[T]) bool {
+	return !Any(iterator, func(element T) bool {
+		return !predicate(element)
+	})
+}
+
+// [First] returns the first element of the iterator and true.
+// If the iterator does not yield any element, the zero value and false are returned.
+func First[T any](iterator cantor.Iterator[T]) (first T, ok bool) {
+	iterator(func(element T) (next bool) {
+		first, ok = element, true
+
+		return false
+	})
+
+	return first, ok
+}
+
+// [ToSlice] collects all elements of the iterator into a slice, preserving their order.
+func ToSlice[T any](iterator cantor.Iterator[T]) []T {
+	var result []T
+
+	iterator(func(element T) (next bool) {
+		result = append(result, element)
+
+		return true
+	})
+
+	return result
+}
@@ -0,0 +1,117 @@
+package iterators_test
+
+import (
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor/iterators"
+)
+
+func TestReduce(t *testing.T) {
+	sum := func(accumulator int, element int) int {
+		return accumulator + element
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		pulled := 0
+
+		if actual := iterators.Reduce(fromSlice[int](&pulled), 10, sum); actual != 10 {
+			t.Errorf("expected 10 but got %d", actual)
+		}
+	})
+
+	t.Run("some", func(t *testing.T) {
+		pulled := 0
+
+		if actual := iterators.Reduce(fromSlice(&pulled, 1, 2, 3), 10, sum); actual != 16 {
+			t.Errorf("expected 16 but got %d", actual)
+		}
+	})
+}
+
+func TestAny(t *testing.T) {
+	isTwo := func(element int) bool {
+		return element == 2
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		pulled := 0
+
+		if iterators.Any(fromSlice[int](&pulled), isTwo) {
+			t.Error("expected false for empty iterator")
+		}
+	})
+
+	t.Run("match", func(t *testing.T) {
+		pulled := 0
+
+		if !iterators.Any(fromSlice(&pulled, 1, 2, 3), isTwo) {
+			t.Error("expected a match")
+		}
+
+		if pulled != 2 {
+			t.Errorf("iterator yielded %d elements instead of 2", pulled)
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		pulled := 0
+
+		if iterators.Any(fromSlice(&pulled, 1, 3), isTwo) {
+			t.Error("expected no match")
+		}
+	})
+}
+
+func TestAll(t *testing.T) {
+	isPositive := func(element int) bool {
+		return element > 0
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		pulled := 0
+
+		if !iterators.All(fromSlice[int](&pulled), isPositive) {
+			t.Error("expected true for empty iterator")
+		}
+	})
+
+	t.Run("all match", func(t *testing.T) {
+		pulled := 0
+
+		if !iterators.All(fromSlice(&pulled, 1, 2, 3), isPositive) {
+			t.Error("expected all elements to match")
+		}
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		if iterators.All(naturals, isPositive) {
+			t.Error("expected a mismatch")
+		}
+	})
+}
+
+func TestFirst(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		pulled := 0
+		first, ok := iterators.First(fromSlice[int](&pulled))
+
+		if ok || first != 0 {
+			t.Errorf("expected (0, false) but got (%d, %t)", first, ok)
+		}
+	})
+
+	t.Run("infinite", func(t *testing.T) {
+		first, ok := iterators.First(naturals)
+
+		if !ok || first != 0 {
+			t.Errorf("expected (0, true) but got (%d, %t)", first, ok)
+		}
+	})
+}
+
+func TestToSlice(t *testing.T) {
+	pulled := 0
+	actual := iterators.ToSlice(fromSlice(&pulled, 3, 1, 2))
+
+	assertSlice(t, actual, []int{3, 1, 2})
+}
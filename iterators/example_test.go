@@ -0,0 +1,35 @@
+package iterators_test
+
+import (
+	"fmt"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/iterators"
+)
+
+// Combinators can be chained to build pipelines over the elements of a set.
+// Only as many elements are evaluated, as are needed to produce the result.
+func Example() {
+	set := cantor.NewHashSet(5, 3, 8, 1, 9, 2)
+
+	smallestOddSquares := iterators.Take(
+		iterators.Map(
+			iterators.Sorted(
+				iterators.Filter(set.Elements(), func(element int) bool {
+					return element%2 == 1
+				}),
+				func(a, b int) int {
+					return a - b
+				},
+			),
+			func(element int) int {
+				return element * element
+			},
+		),
+		3,
+	)
+
+	fmt.Println(iterators.ToSlice(smallestOddSquares))
+	// Output:
+	// [1 9 25]
+}
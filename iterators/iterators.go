@@ -0,0 +1,114 @@
+// Package iterators provides combinators for [pkg/github.com/frederik-jatzkowski/cantor.Iterator].
+// All combinators respect the yield protocol: Once a consumer stops the iteration,
+// the underlying iterators are stopped as well and no further elements are evaluated.
+package iterators
+
+import "github.com/frederik-jatzkowski/cantor"
+
+// [Pair] holds two values, which were yielded together by [Zip].
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// [Take] returns an [cantor.Iterator] yielding at most the first n elements of the given iterator.
+// The underlying iterator is stopped, once n elements have been yielded.
+func Take[T any](iterator cantor.Iterator[T], n int) cantor.Iterator[T] {
+	return func(yield func(element T) (next bool)) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+
+		iterator(func(element T) (next bool) {
+			taken++
+
+			return yield(element) && taken < n
+		})
+	}
+}
+
+// [Skip] returns an [cantor.Iterator] yielding all but the first n elements of the given iterator.
+func Skip[T any](iterator cantor.Iterator[T], n int) cantor.Iterator[T] {
+	return func(yield func(element T) (next bool)) {
+		skipped := 0
+
+		iterator(func(element T) (next bool) {
+			if skipped < n {
+				skipped++
+
+				return true
+			}
+
+			return yield(element)
+		})
+	}
+}
+
+// [Chain] returns an [cantor.Iterator] yielding the elements of all given iterators one after another.
+// Iterators following the one, during which iteration was stopped, are never called.
+func Chain[T any](iterators ...cantor.Iterator[T]) cantor.Iterator[T] {
+	return func(yield func(element T) (next bool)) {
+		stopped := false
+
+		for _, iterator := range iterators {
+			iterator(func(element T) (next bool) {
+				stopped = !yield(element)
+
+				return !stopped
+			})
+
+			if stopped {
+				return
+			}
+		}
+	}
+}
+
+// [Filter] returns an [cantor.Iterator] yielding only those elements of the given iterator,
+// for which the [cantor.Predicate] returns true.
+func Filter[T any](iterator cantor.Iterator[T], predicate cantor.Predicate[T]) cantor.Iterator[T] {
+	return func(yield func(element T) (next bool)) {
+		iterator(func(element T) (next bool) {
+			if !predicate(element) {
+				return true
+			}
+
+			return yield(element)
+		})
+	}
+}
+
+// [Map] returns an [cantor.Iterator] yielding the result of mapper for each element of the given iterator.
+// The results are not deduplicated.
+func Map[T, U any](iterator cantor.Iterator[T], mapper func(element T) U) cantor.Iterator[U] {
+	return func(yield func(element U) (next bool)) {
+		iterator(func(element T) (next bool) {
+			return yield(mapper(element))
+		})
+	}
+}
+
+// [Zip] returns an [cantor.Iterator] yielding pairs of elements of both iterators at the same position.
+// Iteration ends, once one of the iterators is exhausted.
+//
+// Since an [cantor.Iterator] can not be paused, the elements of second are collected before
+// iterating over first. Zipping an infinite iterator is only possible as first argument.
+func Zip[A, B any](first cantor.Iterator[A], second cantor.Iterator[B]) cantor.Iterator[Pair[A, B]] {
+	return func(yield func(element Pair[A, B]) (next bool)) {
+		seconds := ToSlice(second)
+		position := 0
+
+		if len(seconds) == 0 {
+			return
+		}
+
+		first(func(element A) (next bool) {
+			pair := Pair[A, B]{First: element, Second: seconds[position]}
+			position++
+
+			return yield(pair) && position < len(seconds)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package iterators_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/iterators"
+)
+
+// fromSlice returns an iterator over elements, which counts the number of yielded elements in pulled.
+func fromSlice[T any](pulled *int, elements ...T) cantor.Iterator[T] {
+	return func(yield func(element T) (next bool)) {
+		for _, element := range elements {
+			*pulled++
+
+			if !yield(element) {
+				break
+			}
+		}
+	}
+}
+
+func naturals(yield func(element int) (next bool)) {
+	for i := 0; ; i++ {
+		if !yield(i) {
+			break
+		}
+	}
+}
+
+func assertSlice[T any](t *testing.T, actual, expected []T) {
+	t.Helper()
+
+	if len(actual) == 0 && len(expected) == 0 {
+		return
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v but got %v", expected, actual)
+	}
+}
+
+func TestTake(t *testing.T) {
+	t.Run("fewer than available", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Take(fromSlice(&pulled, 1, 2, 3, 4), 2))
+
+		assertSlice(t, actual, []int{1, 2})
+
+		if pulled != 2 {
+			t.Errorf("underlying iterator yielded %d elements instead of 2", pulled)
+		}
+	})
+
+	t.Run("more than available", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Take(fromSlice(&pulled, 1, 2), 5))
+
+		assertSlice(t, actual, []int{1, 2})
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Take(fromSlice(&pulled, 1, 2), 0))
+
+		assertSlice(t, actual, nil)
+
+		if pulled != 0 {
+			t.Errorf("underlying iterator yielded %d elements instead of 0", pulled)
+		}
+	})
+
+	t.Run("infinite", func(t *testing.T) {
+		actual := iterators.ToSlice(iterators.Take(naturals, 3))
+
+		assertSlice(t, actual, []int{0, 1, 2})
+	})
+
+	t.Run("stopped by consumer", func(t *testing.T) {
+		pulled := 0
+		first, _ := iterators.First(iterators.Take(fromSlice(&pulled, 1, 2, 3), 2))
+
+		if first != 1 || pulled != 1 {
+			t.Errorf("expected to pull 1 element and receive 1, pulled %d and received %d", pulled, first)
+		}
+	})
+}
+
+func TestSkip(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Skip(fromSlice(&pulled, 1, 2, 3, 4), 2))
+
+		assertSlice(t, actual, []int{3, 4})
+	})
+
+	t.Run("all", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Skip(fromSlice(&pulled, 1, 2), 3))
+
+		assertSlice(t, actual, nil)
+	})
+
+	t.Run("stopped by consumer", func(t *testing.T) {
+		actual := iterators.ToSlice(iterators.Take(iterators.Skip(naturals, 5), 2))
+
+		assertSlice(t, actual, []int{5, 6})
+	})
+}
+
+func TestChain(t *testing.T) {
+	t.Run("none", func(t *testing.T) {
+		actual := iterators.ToSlice(iterators.Chain[int]())
+
+		assertSlice(t, actual, nil)
+	})
+
+	t.Run("some", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Chain(
+			fromSlice(&pulled, 1, 2),
+			fromSlice[int](&pulled),
+			fromSlice(&pulled, 3),
+		))
+
+		assertSlice(t, actual, []int{1, 2, 3})
+	})
+
+	t.Run("stopped by consumer", func(t *testing.T) {
+		pulledFirst, pulledSecond := 0, 0
+		actual := iterators.ToSlice(iterators.Take(iterators.Chain(
+			fromSlice(&pulledFirst, 1, 2),
+			fromSlice(&pulledSecond, 3, 4),
+			naturals,
+		), 3))
+
+		assertSlice(t, actual, []int{1, 2, 3})
+
+		if pulledSecond != 1 {
+			t.Errorf("second iterator yielded %d elements instead of 1", pulledSecond)
+		}
+	})
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(element int) bool {
+		return element%2 == 0
+	}
+
+	t.Run("some", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Filter(fromSlice(&pulled, 1, 2, 3, 4), isEven))
+
+		assertSlice(t, actual, []int{2, 4})
+	})
+
+	t.Run("stopped by consumer", func(t *testing.T) {
+		actual := iterators.ToSlice(iterators.Take(iterators.Filter(naturals, isEven), 3))
+
+		assertSlice(t, actual, []int{0, 2, 4})
+	})
+}
+
+func TestMap(t *testing.T) {
+	double := func(element int) int {
+		return 2 * element
+	}
+
+	t.Run("some", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Map(fromSlice(&pulled, 1, 2, 3), double))
+
+		assertSlice(t, actual, []int{2, 4, 6})
+	})
+
+	t.Run("stopped by consumer", func(t *testing.T) {
+		actual := iterators.ToSlice(iterators.Take(iterators.Map(naturals, double), 2))
+
+		assertSlice(t, actual, []int{0, 2})
+	})
+}
+
+func TestZip(t *testing.T) {
+	t.Run("same length", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Zip(fromSlice(&pulled, 1, 2), fromSlice(&pulled, "a", "b")))
+
+		assertSlice(t, actual, []iterators.Pair[int, string]{{1, "a"}, {2, "b"}})
+	})
+
+	t.Run("first shorter", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Zip(fromSlice(&pulled, 1), fromSlice(&pulled, "a", "b")))
+
+		assertSlice(t, actual, []iterators.Pair[int, string]{{1, "a"}})
+	})
+
+	t.Run("second shorter", func(t *testing.T) {
+		actual := iterators.ToSlice(iterators.Zip(naturals, func(yield func(element string) (next bool)) {
+			_ = yield("a") && yield("b")
+		}))
+
+		assertSlice(t, actual, []iterators.Pair[int, string]{{0, "a"}, {1, "b"}})
+	})
+
+	t.Run("second empty", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Zip(fromSlice(&pulled, 1, 2), fromSlice[string](&pulled)))
+
+		assertSlice(t, actual, nil)
+
+		if pulled != 0 {
+			t.Errorf("first iterator yielded %d elements instead of 0", pulled)
+		}
+	})
+
+	t.Run("stopped by consumer", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Take(iterators.Zip(naturals, fromSlice(&pulled, 1, 2, 3)), 1))
+
+		assertSlice(t, actual, []iterators.Pair[int, int]{{0, 1}})
+	})
+}
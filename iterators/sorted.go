@@ -0,0 +1,29 @@
+package iterators
+
+import (
+	"sort"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+// [Sorted] returns an [cantor.Iterator] yielding the elements of the given iterator in the order defined by cmp.
+// The function cmp must return a negative number if a < b, a positive number if a > b and zero otherwise.
+// The sort is stable.
+//
+// All elements are collected each time the result is iterated,
+// so the result is a data view and will reflect future changes of the underlying structures.
+func Sorted[T any](iterator cantor.Iterator[T], cmp func(a, b T) int) cantor.Iterator[T] {
+	return func(yield func(element T) (next bool)) {
+		elements := ToSlice(iterator)
+
+		sort.SliceStable(elements, func(i, j int) bool {
+			return cmp(elements[i], elements[j]) < 0
+		})
+
+		for _, element := range elements {
+			if !yield(element) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package iterators_test
+
+import (
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/iterators"
+)
+
+func ascending(a, b int) int {
+	return a - b
+}
+
+func TestSorted(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Sorted(fromSlice(&pulled, 3, 1, 2), ascending))
+
+		assertSlice(t, actual, []int{1, 2, 3})
+	})
+
+	t.Run("stable", func(t *testing.T) {
+		pulled := 0
+		byFirst := func(a, b iterators.Pair[int, string]) int {
+			return a.First - b.First
+		}
+		actual := iterators.ToSlice(iterators.Sorted(
+			fromSlice(&pulled, iterators.Pair[int, string]{2, "a"}, iterators.Pair[int, string]{1, "b"},
+				iterators.Pair[int, string]{2, "c"}),
+			byFirst,
+		))
+
+		assertSlice(t, actual, []iterators.Pair[int, string]{{1, "b"}, {2, "a"}, {2, "c"}})
+	})
+
+	t.Run("stopped by consumer", func(t *testing.T) {
+		pulled := 0
+		actual := iterators.ToSlice(iterators.Take(iterators.Sorted(fromSlice(&pulled, 3, 1, 2), ascending), 2))
+
+		assertSlice(t, actual, []int{1, 2})
+	})
+
+	t.Run("data view", func(t *testing.T) {
+		set := cantor.NewHashSet(2, 1)
+		sorted := iterators.Sorted(set.Elements(), ascending)
+
+		set.Add(0)
+
+		assertSlice(t, iterators.ToSlice(sorted), []int{0, 1, 2})
+	})
+}
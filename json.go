@@ -0,0 +1,123 @@
+package cantor
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// MarshalJSON implements [json.Marshaler] for this [HashSet].
+// The set is encoded as a JSON array of its elements in no particular order.
+// Use [MarshalJSONSorted] for a stable output.
+//
+// Like maps in [encoding/json], a nil set is encoded as JSON null, which is decoded into a nil set again.
+func (set HashSet[T]) MarshalJSON() ([]byte, error) {
+	if set == nil {
+		return []byte("null"), nil
+	}
+
+	return marshalJSON[T](set)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler] for [HashSet].
+// It expects a JSON array of elements. Duplicate elements are deduplicated.
+//
+// Like maps in [encoding/json], an existing set is reused and keeps its elements,
+// while a JSON null sets the set to nil.
+func (set *HashSet[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+
+	err := json.Unmarshal(data, &elements)
+	if err != nil {
+		return err
+	}
+
+	if elements == nil {
+		*set = nil
+
+		return nil
+	}
+
+	if *set == nil {
+		*set = make(HashSet[T], len(elements))
+	}
+
+	for _, element := range elements {
+		(*set)[element] = struct{}{}
+	}
+
+	return nil
+}
+
+// [MarshalJSONSorted] encodes the elements of set as a JSON array in the order defined by cmp.
+// The function cmp must return a negative number if a < b, a positive number if a > b and zero otherwise.
+//
+// If cmp is nil, the elements are ordered by their JSON encoding.
+// In both cases, the output is stable and can be used for diffs or fingerprints.
+func MarshalJSONSorted[T comparable](set ReadableSet[T], cmp func(a, b T) int) ([]byte, error) {
+	elements, err := encodeJSONElements(set.Elements())
+	if err != nil {
+		return nil, err
+	}
+
+	if cmp == nil {
+		sort.Slice(elements, func(i, j int) bool {
+			return bytes.Compare(elements[i].encoded, elements[j].encoded) < 0
+		})
+	} else {
+		sort.Slice(elements, func(i, j int) bool {
+			return cmp(elements[i].element, elements[j].element) < 0
+		})
+	}
+
+	return joinJSONArray(elements), nil
+}
+
+func marshalJSON[T comparable](set ReadableSet[T]) ([]byte, error) {
+	elements, err := encodeJSONElements(set.Elements())
+	if err != nil {
+		return nil, err
+	}
+
+	return joinJSONArray(elements), nil
+}
+
+type encodedElement[T any] struct {
+	element T
+	encoded []byte
+}
+
+func encodeJSONElements[T any](iterator Iterator[T]) (elements []encodedElement[T], err error) {
+	iterator(func(element T) (next bool) {
+		var encoded []byte
+
+		encoded, err = json.Marshal(element)
+		elements = append(elements, encodedElement[T]{element: element, encoded: encoded})
+
+		return err == nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return elements, nil
+}
+
+func joinJSONArray[T any](elements []encodedElement[T]) []byte {
+	var buffer bytes.Buffer
+
+	buffer.WriteByte('[')
+
+	for i, element := range elements {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+
+		buffer.Write(element.encoded)
+	}
+
+	buffer.WriteByte(']')
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,156 @@
+package cantor_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/testutils"
+)
+
+func TestHashSet_MarshalJSON(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		data, err := json.Marshal(cantor.NewHashSet[string]())
+		if err != nil || string(data) != "[]" {
+			t.Errorf("expected [] but got %s (%v)", data, err)
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var set cantor.HashSet[string]
+
+		data, err := json.Marshal(set)
+		if err != nil || string(data) != "null" {
+			t.Errorf("expected null but got %s (%v)", data, err)
+		}
+
+		decoded := cantor.NewHashSet("a")
+		if err = json.Unmarshal(data, &decoded); err != nil || decoded != nil {
+			t.Errorf("expected a nil set after the round trip but got %v (%v)", decoded, err)
+		}
+	})
+
+	t.Run("non-string keys", func(t *testing.T) {
+		data, err := json.Marshal(cantor.NewHashSet(testutils.Person{Id: 1, Name: "Jeff", Age: 21}))
+		if err != nil || string(data) != `[{"Id":1,"Name":"Jeff","Age":21}]` {
+			t.Errorf("unexpected result %s (%v)", data, err)
+		}
+	})
+
+	t.Run("unsupported element", func(t *testing.T) {
+		_, err := json.Marshal(cantor.NewHashSet(make(chan int)))
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		data, err := json.Marshal(map[string]cantor.HashSet[int]{"a": cantor.NewHashSet(1)})
+		if err != nil || string(data) != `{"a":[1]}` {
+			t.Errorf("unexpected result %s (%v)", data, err)
+		}
+	})
+}
+
+func TestHashSet_UnmarshalJSON(t *testing.T) {
+	t.Run("into nil set", func(t *testing.T) {
+		var set cantor.HashSet[int]
+
+		err := json.Unmarshal([]byte("[1, 2, 2, 3]"), &set)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !set.Equals(cantor.NewHashSet(1, 2, 3)) {
+			t.Errorf("expected {1, 2, 3} but got %v", set)
+		}
+	})
+
+	t.Run("into existing set", func(t *testing.T) {
+		set := cantor.NewHashSet(0)
+
+		err := json.Unmarshal([]byte("[1]"), &set)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !set.Equals(cantor.NewHashSet(0, 1)) {
+			t.Errorf("expected {0, 1} but got %v", set)
+		}
+	})
+
+	t.Run("null", func(t *testing.T) {
+		set := cantor.NewHashSet(0)
+
+		err := json.Unmarshal([]byte("null"), &set)
+		if err != nil || set != nil {
+			t.Errorf("expected nil set but got %v (%v)", set, err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var set cantor.HashSet[int]
+
+		err := json.Unmarshal([]byte(`{"a":{}}`), &set)
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		expected := cantor.NewHashSet(testutils.Person{Id: 1, Name: "Jeff"}, testutils.Person{Id: 2, Name: "Mary"})
+
+		data, err := json.Marshal(expected)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var actual cantor.HashSet[testutils.Person]
+
+		err = json.Unmarshal(data, &actual)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !actual.Equals(expected) {
+			t.Errorf("expected %v but got %v", expected, actual)
+		}
+	})
+}
+
+func TestMarshalJSONSorted(t *testing.T) {
+	t.Run("by encoding", func(t *testing.T) {
+		data, err := cantor.MarshalJSONSorted[string](cantor.NewHashSet("c", "a", "b"), nil)
+		if err != nil || string(data) != `["a","b","c"]` {
+			t.Errorf("unexpected result %s (%v)", data, err)
+		}
+	})
+
+	t.Run("by cmp", func(t *testing.T) {
+		descending := func(a, b int) int {
+			return b - a
+		}
+
+		data, err := cantor.MarshalJSONSorted[int](cantor.NewHashSet(1, 10, 2), descending)
+		if err != nil || string(data) != `[10,2,1]` {
+			t.Errorf("unexpected result %s (%v)", data, err)
+		}
+	})
+
+	t.Run("derived set", func(t *testing.T) {
+		set := cantor.NewHashSet(1, 2, 3).Union(cantor.NewHashSet(4)).Difference(cantor.NewHashSet(2))
+
+		data, err := cantor.MarshalJSONSorted(set, nil)
+		if err != nil || string(data) != `[1,3,4]` {
+			t.Errorf("unexpected result %s (%v)", data, err)
+		}
+	})
+
+	t.Run("unsupported element", func(t *testing.T) {
+		_, err := cantor.MarshalJSONSorted[chan int](cantor.NewHashSet(make(chan int)), nil)
+		if err == nil || !strings.Contains(err.Error(), "chan") {
+			t.Errorf("expected an error for unsupported type but got %v", err)
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package mapped
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+// [Builder] writes files, which can be opened by [Open].
+type Builder[T comparable] struct {
+	codec    Codec[T]
+	interval int
+}
+
+// [NewBuilder] returns a [Builder] for elements encoded by codec.
+// For keys of variable width, every interval-th key is stored completely and referenced by the sparse index.
+// Larger intervals lead to smaller files, but slower lookups.
+//
+// It panics, if interval is smaller than one.
+func NewBuilder[T comparable](codec Codec[T], interval int) *Builder[T] {
+	if interval < 1 {
+		panic(fmt.Sprintf("mapped: interval must be positive, got %d", interval))
+	}
+
+	return &Builder[T]{codec: codec, interval: interval}
+}
+
+// span is the position of a key in a buffer.
+type span struct {
+	start, end int
+}
+
+// Build sorts the elements of the iterator by their keys and writes them to the file at path.
+// Duplicate elements are removed.
+//
+// All keys are encoded and sorted in memory before the file is written,
+// so building a file needs memory for the encoded keys of all elements.
+//
+// The file is replaced atomically, so that processes, which have mapped the previous file, are not affected.
+func (builder *Builder[T]) Build(path string, elements cantor.Iterator[T]) error {
+	buffer, keys, err := builder.sortedKeys(elements)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(path, builder.encode(buffer, keys))
+}
+
+// sortedKeys encodes the elements into buffer and returns the positions of the distinct keys in ascending order.
+func (builder *Builder[T]) sortedKeys(elements cantor.Iterator[T]) (buffer []byte, keys []span, err error) {
+	width := builder.codec.Width()
+
+	elements(func(element T) bool {
+		start := len(buffer)
+		buffer = builder.codec.AppendKey(buffer, element)
+
+		if width > 0 && len(buffer)-start != width {
+			err = fmt.Errorf("mapped: key of %v has length %d instead of %d", element, len(buffer)-start, width)
+
+			return false
+		}
+
+		keys = append(keys, span{start: start, end: len(buffer)})
+
+		return true
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(buffer[keys[i].start:keys[i].end], buffer[keys[j].start:keys[j].end]) < 0
+	})
+
+	distinct := keys[:0]
+
+	for i, key := range keys {
+		if i == 0 || !bytes.Equal(buffer[key.start:key.end], buffer[keys[i-1].start:keys[i-1].end]) {
+			distinct = append(distinct, key)
+		}
+	}
+
+	return buffer, distinct, nil
+}
+
+func (builder *Builder[T]) encode(buffer []byte, keys []span) []byte {
+	header := header{width: builder.codec.Width(), count: len(keys)}
+	data := make([]byte, headerSize)
+
+	var (
+		previous []byte
+		index    []byte
+	)
+
+	if header.width == 0 {
+		header.interval = builder.interval
+	}
+
+	for i, position := range keys {
+		key := buffer[position.start:position.end]
+
+		switch {
+		case header.width > 0:
+			data = append(data, key...)
+		case i%header.interval == 0:
+			index = appendOffset(index, len(data))
+			data = appendKey(data, nil, key)
+		default:
+			data = appendKey(data, previous, key)
+		}
+
+		previous = key
+	}
+
+	header.indexOffset = len(data)
+	copy(data, encodeHeader(header))
+
+	return append(data, index...)
+}
+
+// writeFile writes data to a temporary file, which is synced and renamed to path.
+func writeFile(path string, data []byte) error {
+	temporary := path + ".tmp"
+
+	file, err := os.Create(temporary)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(temporary, path)
+	}
+
+	if err != nil {
+		_ = os.Remove(temporary)
+	}
+
+	return err
+}
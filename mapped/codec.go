@@ -0,0 +1,78 @@
+package mapped
+
+import "encoding/binary"
+
+// [Codec] converts elements to keys, which are stored in a file read by [Open].
+// The keys are sorted byte-wise, so the order of the keys defines the order of the elements.
+type Codec[T any] interface {
+	// AppendKey appends the key of element to buffer. Different elements must have different keys.
+	AppendKey(buffer []byte, element T) []byte
+	// Decode returns the element of key. It must not retain key, since the memory is reused.
+	Decode(key []byte) T
+	// Width returns the length of all keys or zero, if the keys have variable length.
+	Width() int
+}
+
+type uint64Codec struct{}
+
+// [Uint64Codec] returns a [Codec], which stores elements as fixed-width big-endian keys.
+// The order of the keys is the natural order of the elements.
+func Uint64Codec() Codec[uint64] {
+	return uint64Codec{}
+}
+
+func (uint64Codec) AppendKey(buffer []byte, element uint64) []byte {
+	var key [8]byte
+
+	binary.BigEndian.PutUint64(key[:], element)
+
+	return append(buffer, key[:]...)
+}
+
+func (uint64Codec) Decode(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key)
+}
+
+func (uint64Codec) Width() int {
+	return 8
+}
+
+type int64Codec struct{}
+
+// [Int64Codec] returns a [Codec], which stores elements as fixed-width big-endian keys.
+// The sign bit is flipped, so that the order of the keys is the natural order of the elements.
+func Int64Codec() Codec[int64] {
+	return int64Codec{}
+}
+
+func (int64Codec) AppendKey(buffer []byte, element int64) []byte {
+	return uint64Codec{}.AppendKey(buffer, uint64(element)^1<<63)
+}
+
+func (int64Codec) Decode(key []byte) int64 {
+	return int64(uint64Codec{}.Decode(key) ^ 1<<63)
+}
+
+func (int64Codec) Width() int {
+	return 8
+}
+
+type stringCodec struct{}
+
+// [StringCodec] returns a [Codec], which stores strings as their raw bytes.
+// The keys are prefix-compressed and the order of the keys is the byte-wise order of the strings.
+func StringCodec() Codec[string] {
+	return stringCodec{}
+}
+
+func (stringCodec) AppendKey(buffer []byte, element string) []byte {
+	return append(buffer, element...)
+}
+
+func (stringCodec) Decode(key []byte) string {
+	return string(key)
+}
+
+func (stringCodec) Width() int {
+	return 0
+}
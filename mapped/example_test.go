@@ -0,0 +1,41 @@
+package mapped_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/mapped"
+)
+
+// Large reference sets are built once and mapped by all processes, which need them.
+func ExampleSortedSet() {
+	dir, err := os.MkdirTemp("", "hosts")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "blocked")
+	hosts := cantor.NewHashSet("ads.example.com", "tracker.example.org", "ads.example.net")
+
+	err = mapped.NewBuilder(mapped.StringCodec(), 16).Build(path, hosts.Elements())
+	if err != nil {
+		panic(err)
+	}
+
+	blocked, err := mapped.Open(path, mapped.StringCodec())
+	if err != nil {
+		panic(err)
+	}
+
+	defer blocked.Close()
+
+	fmt.Println(blocked)
+	fmt.Println(blocked.Contains("tracker.example.org"), blocked.Contains("example.org"))
+	// Output:
+	// {ads.example.com, ads.example.net, tracker.example.org}
+	// true false
+}
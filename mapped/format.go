@@ -0,0 +1,145 @@
+package mapped
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// A file starts with a header of headerSize bytes:
+//
+//	magic        [4]byte  "CSET"
+//	version      uint32
+//	width        uint32   the length of all keys or zero for prefix-compressed keys
+//	interval     uint32   the number of prefix-compressed keys per entry of the sparse index
+//	count        uint64   the number of keys
+//	index offset uint64   the offset of the sparse index
+//
+// All integers are little-endian. Fixed-width keys follow the header without any separators.
+// Prefix-compressed keys are stored in blocks of interval keys. Each key is stored as the uvarint length
+// of the prefix shared with the previous key of the block, the uvarint length of the remaining suffix and the suffix.
+// The first key of each block is stored completely, so that blocks can be decoded independently.
+// The sparse index holds the offset of each block as uint64 and is searched for the block, which might contain a key.
+const (
+	magic      = "CSET"
+	version    = 1
+	headerSize = 32
+	offsetSize = 8
+)
+
+type header struct {
+	width       int
+	interval    int
+	count       int
+	indexOffset int
+}
+
+func parseHeader(data []byte) (header, error) {
+	if string(data[:len(magic)]) != magic {
+		return header{}, fmt.Errorf("%w: missing magic number", ErrFormat)
+	}
+
+	if fileVersion := binary.LittleEndian.Uint32(data[4:]); fileVersion != version {
+		return header{}, fmt.Errorf("%w: unsupported version %d", ErrFormat, fileVersion)
+	}
+
+	count := binary.LittleEndian.Uint64(data[16:])
+	indexOffset := binary.LittleEndian.Uint64(data[24:])
+
+	// both are bounded by the file size, which makes the following arithmetic safe
+	if count > uint64(len(data)) || indexOffset > uint64(len(data)) || indexOffset < headerSize {
+		return header{}, fmt.Errorf("%w: invalid header", ErrFormat)
+	}
+
+	return header{
+		width:       int(binary.LittleEndian.Uint32(data[8:])),
+		interval:    int(binary.LittleEndian.Uint32(data[12:])),
+		count:       int(count),
+		indexOffset: int(indexOffset),
+	}, nil
+}
+
+// validSizes returns whether the sizes of the keys and the index match the header.
+func (header header) validSizes(size int) bool {
+	keys := header.indexOffset - headerSize
+	if header.width > 0 {
+		return header.interval == 0 && header.indexOffset == size &&
+			keys%header.width == 0 && keys/header.width == header.count
+	}
+
+	if header.interval == 0 {
+		return false
+	}
+
+	index := size - header.indexOffset
+	blocks := (header.count + header.interval - 1) / header.interval
+
+	return index%offsetSize == 0 && index/offsetSize == blocks
+}
+
+// nextKey decodes the prefix-compressed key at offset, which follows previous, into previous.
+// It returns the key and the offset of the next key.
+func nextKey(data []byte, offset int, previous []byte) (key []byte, next int, err error) {
+	shared, n := binary.Uvarint(data[offset:])
+	if n <= 0 || shared > uint64(len(previous)) {
+		return nil, 0, fmt.Errorf("%w: invalid key at offset %d", ErrFormat, offset)
+	}
+
+	suffix, m := binary.Uvarint(data[offset+n:])
+	if m <= 0 || suffix > uint64(len(data)-offset-n-m) {
+		return nil, 0, fmt.Errorf("%w: invalid key at offset %d", ErrFormat, offset)
+	}
+
+	next = offset + n + m + int(suffix)
+
+	return append(previous[:shared], data[offset+n+m:next]...), next, nil
+}
+
+// appendKey appends key prefix-compressed against previous to buffer.
+func appendKey(buffer, previous, key []byte) []byte {
+	shared := 0
+	for shared < len(previous) && shared < len(key) && previous[shared] == key[shared] {
+		shared++
+	}
+
+	buffer = appendUvarint(buffer, uint64(shared))
+	buffer = appendUvarint(buffer, uint64(len(key)-shared))
+
+	return append(buffer, key[shared:]...)
+}
+
+func appendOffset(index []byte, offset int) []byte {
+	var encoded [offsetSize]byte
+
+	binary.LittleEndian.PutUint64(encoded[:], uint64(offset))
+
+	return append(index, encoded[:]...)
+}
+
+func appendUvarint(buffer []byte, value uint64) []byte {
+	var encoded [binary.MaxVarintLen64]byte
+
+	return append(buffer, encoded[:binary.PutUvarint(encoded[:], value)]...)
+}
+
+// checkOrder returns an error, if key does not follow previous in strictly ascending order.
+func checkOrder(previous, key []byte, i int) error {
+	if i > 0 && bytes.Compare(previous, key) >= 0 {
+		return fmt.Errorf("%w: key %d is not sorted", ErrFormat, i)
+	}
+
+	return nil
+}
+
+func encodeHeader(header header) []byte {
+	data := make([]byte, headerSize)
+
+	copy(data, magic)
+	binary.LittleEndian.PutUint32(data[4:], version)
+	binary.LittleEndian.PutUint32(data[8:], uint32(header.width))
+	binary.LittleEndian.PutUint32(data[12:], uint32(header.interval))
+	binary.LittleEndian.PutUint64(data[16:], uint64(header.count))
+	binary.LittleEndian.PutUint64(data[24:], uint64(header.indexOffset))
+
+	return data
+}
@@ -0,0 +1,406 @@
+// Package mapped implements read-only sets, which are stored in files and memory-mapped.
+//
+// A [SortedSet] holds sorted keys, which are either fixed-width or prefix-compressed with a sparse index.
+// Since the file is mapped instead of read, the keys are not copied into the heap and all processes
+// using the same file share its pages in memory. This is useful for large, static reference sets.
+// Files are written by a [Builder].
+package mapped
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+var (
+	// [ErrFormat] is returned when opening a file, which was not written by a [Builder] or is damaged.
+	ErrFormat = errors.New("mapped: invalid file format")
+	// [ErrClosed] is returned when closing a [SortedSet] twice.
+	ErrClosed = errors.New("mapped: set is closed")
+)
+
+// [SortedSet] implements [cantor.ReadableSet] for a memory-mapped file written by a [Builder].
+// Contains uses binary search, Elements iterates the keys in ascending order without copying them
+// and Size is O(1).
+//
+// A [SortedSet] is safe for concurrent reads. It must not be used after [SortedSet.Close].
+// The file must not be modified while it is mapped. Replace it by writing a new file with a [Builder] instead.
+type SortedSet[T comparable] struct {
+	codec Codec[T]
+	data  []byte
+	header
+}
+
+// [Open] maps the file at path and returns it as [SortedSet].
+// Only the header and the sparse index are validated, which takes O(n/interval) time for prefix-compressed keys
+// and O(1) time for fixed-width keys, so that the pages of the keys are only read, when they are used.
+// Use [SortedSet.Verify] to validate all keys of files, which might be damaged.
+//
+// It returns [ErrFormat], if the file is not valid or its keys do not match the codec.
+func Open[T comparable](path string, codec Codec[T]) (*SortedSet[T], error) {
+	data, err := mapFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &SortedSet[T]{codec: codec, data: data}
+
+	err = set.validate()
+	if err != nil {
+		_ = unmapFile(data)
+
+		return nil, err
+	}
+
+	return set, nil
+}
+
+func (set *SortedSet[T]) validate() (err error) {
+	set.header, err = parseHeader(set.data)
+	if err != nil {
+		return err
+	}
+
+	if set.width != set.codec.Width() {
+		return fmt.Errorf("%w: key width %d does not match the codec", ErrFormat, set.width)
+	}
+
+	if !set.validSizes(len(set.data)) {
+		return fmt.Errorf("%w: invalid size", ErrFormat)
+	}
+
+	if set.width > 0 {
+		return nil
+	}
+
+	return set.validateIndex()
+}
+
+// validateIndex checks, that the blocks are in order and start with complete keys in ascending order,
+// so that the index can be searched.
+func (set *SortedSet[T]) validateIndex() error {
+	var previous []byte
+
+	for block := 0; block < set.blocks(); block++ {
+		if !set.validOffset(block) {
+			return fmt.Errorf("%w: invalid index entry %d", ErrFormat, block)
+		}
+
+		key, _, err := nextKey(set.data[:set.indexOffset], set.blockOffset(block), nil)
+		if err == nil {
+			err = checkOrder(previous, key, block*set.interval)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		previous = key
+	}
+
+	return nil
+}
+
+// validOffset returns whether the block starts after the previous block and before the index.
+func (set *SortedSet[T]) validOffset(block int) bool {
+	offset := set.blockOffset(block)
+	if block == 0 {
+		return offset == headerSize
+	}
+
+	return offset > set.blockOffset(block-1) && offset < set.indexOffset
+}
+
+// Verify validates the encoding and the order of all keys. Unlike [Open], it reads the whole file in O(n) time.
+// Damaged keys, which are not detected by [Open], are skipped by Contains and Elements.
+//
+// It returns [ErrFormat], if a key is damaged or not sorted.
+func (set *SortedSet[T]) Verify() error {
+	if set.width > 0 {
+		return set.verifyFixed()
+	}
+
+	return set.verifyCompressed()
+}
+
+func (set *SortedSet[T]) verifyFixed() error {
+	for i := 1; i < set.count; i++ {
+		err := checkOrder(set.key(i-1), set.key(i), i)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (set *SortedSet[T]) verifyCompressed() (err error) {
+	var key, previous []byte
+
+	offset := headerSize
+
+	for i := 0; i < set.count; i++ {
+		if i%set.interval == 0 {
+			if set.blockOffset(i/set.interval) != offset {
+				return fmt.Errorf("%w: invalid index entry %d", ErrFormat, i/set.interval)
+			}
+
+			key = key[:0]
+		}
+
+		key, offset, err = nextKey(set.data[:set.indexOffset], offset, key)
+		if err == nil {
+			err = checkOrder(previous, key, i)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		previous = append(previous[:0], key...)
+	}
+
+	if offset != set.indexOffset {
+		return fmt.Errorf("%w: unexpected data after the last key", ErrFormat)
+	}
+
+	return nil
+}
+
+// key returns the i-th fixed-width key.
+func (set *SortedSet[T]) key(i int) []byte {
+	start := headerSize + i*set.width
+
+	return set.data[start : start+set.width : start+set.width]
+}
+
+func (set *SortedSet[T]) blocks() int {
+	return (len(set.data) - set.indexOffset) / offsetSize
+}
+
+func (set *SortedSet[T]) blockOffset(block int) int {
+	return int(binary.LittleEndian.Uint64(set.data[set.indexOffset+block*offsetSize:]))
+}
+
+// firstKey returns the first key of a block, which is stored completely and can be used without copying it.
+func (set *SortedSet[T]) firstKey(block int) []byte {
+	offset := set.blockOffset(block)
+	_, n := binary.Uvarint(set.data[offset:])
+	length, m := binary.Uvarint(set.data[offset+n:])
+	start := offset + n + m
+	end := start + int(length)
+
+	return set.data[start:end:end]
+}
+
+// scanBlock calls visit for the keys of a block in ascending order, until visit returns false.
+// The memory of key is reused for the next key.
+func (set *SortedSet[T]) scanBlock(block int, key []byte, visit func(key []byte) (next bool)) (next bool) {
+	offset := set.blockOffset(block)
+	keys := set.count - block*set.interval
+
+	if keys > set.interval {
+		keys = set.interval
+	}
+
+	key = key[:0]
+
+	for i := 0; i < keys; i++ {
+		var err error
+
+		key, offset, err = nextKey(set.data[:set.indexOffset], offset, key)
+		if err != nil {
+			// the keys are only validated by Verify, so the rest of a damaged block is skipped
+			return true
+		}
+
+		if !visit(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Close unmaps the file. It returns [ErrClosed], if the set was already closed.
+func (set *SortedSet[T]) Close() error {
+	if set.data == nil {
+		return ErrClosed
+	}
+
+	data := set.data
+	set.data = nil
+
+	return unmapFile(data)
+}
+
+// Contains returns whether the element is contained in this set.
+//
+// The time complexity of this method is O(log(n)).
+func (set *SortedSet[T]) Contains(element T) bool {
+	var buffer [64]byte
+
+	key := set.codec.AppendKey(buffer[:0], element)
+	if set.width > 0 {
+		return set.containsFixed(key)
+	}
+
+	return set.containsCompressed(key)
+}
+
+func (set *SortedSet[T]) containsFixed(key []byte) bool {
+	i := sort.Search(set.count, func(i int) bool {
+		return bytes.Compare(set.key(i), key) >= 0
+	})
+
+	return i < set.count && bytes.Equal(set.key(i), key)
+}
+
+func (set *SortedSet[T]) containsCompressed(key []byte) (found bool) {
+	// the first block starting after key
+	block := sort.Search(set.blocks(), func(block int) bool {
+		return bytes.Compare(set.firstKey(block), key) > 0
+	})
+	if block == 0 {
+		return false
+	}
+
+	set.scanBlock(block-1, nil, func(candidate []byte) bool {
+		comparison := bytes.Compare(candidate, key)
+		found = comparison == 0
+
+		return comparison < 0
+	})
+
+	return found
+}
+
+// Union returns a [cantor.ReadableSet] representing the set union of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *SortedSet[T]) Union(other cantor.ReadableSet[T]) cantor.ReadableSet[T] {
+	return cantor.NewUnion[T](set, other)
+}
+
+// Intersect returns a [cantor.ReadableSet] representing the set intersection of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *SortedSet[T]) Intersect(other cantor.Container[T]) cantor.ReadableSet[T] {
+	return cantor.NewIntersection[T](set, other)
+}
+
+// Complement returns a [cantor.ImplicitSet], representing all elements not contained in this set.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *SortedSet[T]) Complement() cantor.ImplicitSet[T] {
+	return cantor.NewImplicitSet(func(element T) bool {
+		return !set.Contains(element)
+	})
+}
+
+// Difference returns a [cantor.ReadableSet] with all elements of this set, which are not contained in the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *SortedSet[T]) Difference(other cantor.Container[T]) cantor.ReadableSet[T] {
+	return cantor.NewDifference[T](set, other)
+}
+
+// SymmetricDifference returns a [cantor.ReadableSet] representing the set with all elements of this
+// and the other set, which are contained in exactly one of the two.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *SortedSet[T]) SymmetricDifference(other cantor.ReadableSet[T]) cantor.ReadableSet[T] {
+	return set.Difference(other).Union(other.Difference(set))
+}
+
+// Equals returns true, if this set and the other set represent exactly the same elements.
+func (set *SortedSet[T]) Equals(other cantor.ReadableSet[T]) bool {
+	return set.SymmetricDifference(other).Size() == 0
+}
+
+// Subset returns true, if all elements of this set are contained in the other container.
+func (set *SortedSet[T]) Subset(other cantor.Container[T]) bool {
+	return set.Difference(other).Size() == 0
+}
+
+// StrictSubset returns true, if all elements of this set are contained in the other set
+// and the sets are not equal.
+func (set *SortedSet[T]) StrictSubset(other cantor.ReadableSet[T]) bool {
+	return set.Subset(other) && !set.Equals(other)
+}
+
+// Elements returns a [cantor.Iterator] over the elements of this set in ascending order of their keys.
+func (set *SortedSet[T]) Elements() cantor.Iterator[T] {
+	return func(yield func(element T) (next bool)) {
+		visit := func(key []byte) bool {
+			return yield(set.codec.Decode(key))
+		}
+
+		if set.width > 0 {
+			for i := 0; i < set.count; i++ {
+				if !visit(set.key(i)) {
+					return
+				}
+			}
+
+			return
+		}
+
+		key := make([]byte, 0, 64)
+
+		for block := 0; block < set.blocks(); block++ {
+			if !set.scanBlock(block, key, visit) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of elements in this set.
+//
+// The time complexity of this method is O(1).
+func (set *SortedSet[T]) Size() int {
+	return set.count
+}
+
+// String implements [fmt.Stringer] for this set and lists the elements in ascending order of their keys.
+func (set *SortedSet[T]) String() string {
+	// a union of a single set formats its elements in their order like all other sets
+	return fmt.Sprint(cantor.NewUnion[T](set))
+}
+
+// MarshalJSON implements [json.Marshaler] and encodes the set as a JSON array of its elements
+// in ascending order of their keys.
+func (set *SortedSet[T]) MarshalJSON() (data []byte, err error) {
+	data = append(data, '[')
+
+	set.Elements()(func(element T) bool {
+		var encoded []byte
+
+		encoded, err = json.Marshal(element)
+		if len(data) > 1 {
+			data = append(data, ',')
+		}
+
+		data = append(data, encoded...)
+
+		return err == nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, ']'), nil
+}
+
+// SafeForConcurrentReads implements [cantor.ConcurrentReader] and returns true,
+// since the file is never modified.
+func (set *SortedSet[T]) SafeForConcurrentReads() bool {
+	return true
+}
@@ -0,0 +1,377 @@
+package mapped_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/sets"
+	"github.com/frederik-jatzkowski/cantor/mapped"
+)
+
+// byteCodec stores bytes as fixed-width keys.
+type byteCodec struct{}
+
+func (byteCodec) AppendKey(buffer []byte, element byte) []byte {
+	return append(buffer, element)
+}
+
+func (byteCodec) Decode(key []byte) byte {
+	return key[0]
+}
+
+func (byteCodec) Width() int {
+	return 1
+}
+
+// decimalCodec stores bytes as their decimal representation, which has a variable width.
+type decimalCodec struct{}
+
+func (decimalCodec) AppendKey(buffer []byte, element byte) []byte {
+	return strconv.AppendInt(buffer, int64(element), 10)
+}
+
+func (decimalCodec) Decode(key []byte) byte {
+	element, _ := strconv.Atoi(string(key))
+
+	return byte(element)
+}
+
+func (decimalCodec) Width() int {
+	return 0
+}
+
+func build[T comparable](t *testing.T, codec mapped.Codec[T], interval int, elements ...T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "set")
+
+	err := mapped.NewBuilder(codec, interval).Build(path, cantor.NewHashSet(elements...).Elements())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return path
+}
+
+func open[T comparable](t *testing.T, path string, codec mapped.Codec[T]) *mapped.SortedSet[T] {
+	t.Helper()
+
+	set, err := mapped.Open(path, codec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = set.Close()
+	})
+
+	return set
+}
+
+func TestSortedSet_ReadableSet(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		codec mapped.Codec[byte]
+	}{
+		{name: "fixed width", codec: byteCodec{}},
+		{name: "prefix compressed", codec: decimalCodec{}},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			sets.RunTestsForReadableSet(t, func(elements ...byte) cantor.ReadableSet[byte] {
+				return open(t, build(t, test.codec, 3, elements...), test.codec)
+			})
+		})
+	}
+}
+
+func TestSortedSet_order(t *testing.T) {
+	t.Run("uint64", func(t *testing.T) {
+		set := open(t, build[uint64](t, mapped.Uint64Codec(), 1, 300, 1, 1<<40, 2), mapped.Uint64Codec())
+		if set.String() != "{1, 2, 300, 1099511627776}" {
+			t.Errorf("unexpected order: %v", set)
+		}
+	})
+
+	t.Run("int64", func(t *testing.T) {
+		set := open(t, build[int64](t, mapped.Int64Codec(), 1, 3, -300, 0, -1), mapped.Int64Codec())
+		if data, err := set.MarshalJSON(); err != nil || string(data) != "[-300,-1,0,3]" {
+			t.Errorf("unexpected order: %s, %v", data, err)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		elements := []string{"b", "", "ab", "abc", "a", "abd", "bcd", "bc", "c"}
+		set := open(t, build(t, mapped.StringCodec(), 2, elements...), mapped.StringCodec())
+
+		if data, err := set.MarshalJSON(); err != nil ||
+			string(data) != `["","a","ab","abc","abd","b","bc","bcd","c"]` {
+			t.Errorf("unexpected order: %s, %v", data, err)
+		}
+
+		for _, element := range elements {
+			if !set.Contains(element) {
+				t.Errorf("expected %q to be contained", element)
+			}
+		}
+
+		for _, element := range []string{"aa", "abcd", "abe", "bb", "d"} {
+			if set.Contains(element) {
+				t.Errorf("expected %q not to be contained", element)
+			}
+		}
+
+		if set.Size() != len(elements) || !set.SafeForConcurrentReads() {
+			t.Errorf("unexpected size: %d", set.Size())
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		set := open(t, build(t, mapped.StringCodec(), 2), mapped.StringCodec())
+		if set.Contains("") || set.Size() != 0 {
+			t.Errorf("expected the set to be empty, got %v", set)
+		}
+	})
+}
+
+// floatCodec stores the bits of floats as fixed-width keys, which does not preserve their order.
+type floatCodec struct{}
+
+func (floatCodec) AppendKey(buffer []byte, element float64) []byte {
+	return mapped.Uint64Codec().AppendKey(buffer, math.Float64bits(element))
+}
+
+func (floatCodec) Decode(key []byte) float64 {
+	return math.Float64frombits(mapped.Uint64Codec().Decode(key))
+}
+
+func (floatCodec) Width() int {
+	return 8
+}
+
+func TestSortedSet_MarshalJSON(t *testing.T) {
+	set := open[float64](t, build[float64](t, floatCodec{}, 1, 1, math.NaN()), floatCodec{})
+	if _, err := set.MarshalJSON(); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestSortedSet_Close(t *testing.T) {
+	set, err := mapped.Open(build[uint64](t, mapped.Uint64Codec(), 1, 1), mapped.Uint64Codec())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err = set.Close(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err = set.Close(); !errors.Is(err, mapped.ErrClosed) {
+		t.Errorf("expected %v, got %v", mapped.ErrClosed, err)
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	t.Run("invalid interval", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected a panic")
+			}
+		}()
+
+		mapped.NewBuilder(mapped.StringCodec(), 0)
+	})
+
+	t.Run("duplicates", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "set")
+		iterator := cantor.Iterator[string](func(yield func(element string) (next bool)) {
+			_ = yield("b") && yield("a") && yield("b")
+		})
+
+		if err := mapped.NewBuilder(mapped.StringCodec(), 1).Build(path, iterator); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if set := open(t, path, mapped.StringCodec()); set.String() != "{a, b}" {
+			t.Errorf("unexpected elements: %v", set)
+		}
+	})
+
+	t.Run("invalid key width", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "set")
+
+		err := mapped.NewBuilder[byte](invalidCodec{}, 1).Build(path, cantor.NewHashSet[byte](1, 2).Elements())
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "missing", "set")
+		if err := mapped.NewBuilder(mapped.StringCodec(), 1).Build(path, cantor.NewHashSet("a").Elements()); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+
+	t.Run("path is a directory", func(t *testing.T) {
+		path := t.TempDir()
+		if err := os.WriteFile(filepath.Join(path, "file"), nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := mapped.NewBuilder(mapped.StringCodec(), 1).Build(path, cantor.NewHashSet("a").Elements()); err == nil {
+			t.Errorf("expected an error")
+		}
+
+		if _, err := os.Stat(path + ".tmp"); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("expected the temporary file to be removed, got %v", err)
+		}
+	})
+}
+
+// invalidCodec claims a fixed width, which does not match its keys.
+type invalidCodec struct {
+	byteCodec
+}
+
+func (invalidCodec) Width() int {
+	return 2
+}
+
+func TestOpen_errors(t *testing.T) {
+	// the keys a, ab and b with an interval of 2 are stored as follows:
+	// 0-31 header, 32-34 key a, 35-37 key ab, 38-40 key b, 41-56 index with the offsets 32 and 38
+	for _, test := range []struct {
+		name   string
+		damage func(data []byte) []byte
+	}{
+		{name: "file too small", damage: func(data []byte) []byte { return data[:31] }},
+		{name: "magic number", damage: set(0, 'X')},
+		{name: "version", damage: set(4, 2)},
+		{name: "count", damage: putUint64(16, 1<<40)},
+		{name: "index offset", damage: putUint64(24, 0)},
+		{name: "interval", damage: set(12, 0)},
+		{name: "index size", damage: func(data []byte) []byte { return append(data, make([]byte, 8)...) }},
+		{name: "index entry", damage: set(41, 33)},
+		{name: "shared prefix", damage: set(32, 1)},
+		{name: "order", damage: set(34, 'b')},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			path := build(t, mapped.StringCodec(), 2, "a", "ab", "b")
+			damage(t, path, test.damage)
+
+			if _, err := mapped.Open(path, mapped.StringCodec()); !errors.Is(err, mapped.ErrFormat) {
+				t.Errorf("expected %v, got %v", mapped.ErrFormat, err)
+			}
+		})
+	}
+
+	t.Run("codec width", func(t *testing.T) {
+		path := build(t, mapped.StringCodec(), 2, "a")
+		if _, err := mapped.Open(path, mapped.Uint64Codec()); !errors.Is(err, mapped.ErrFormat) {
+			t.Errorf("expected %v, got %v", mapped.ErrFormat, err)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := mapped.Open(filepath.Join(t.TempDir(), "missing"), mapped.StringCodec()); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
+
+func TestSortedSet_Verify(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		for _, set := range []interface{ Verify() error }{
+			open(t, build(t, mapped.StringCodec(), 2, "a", "ab", "b"), mapped.StringCodec()),
+			open(t, build[uint64](t, mapped.Uint64Codec(), 1, 1, 2), mapped.Uint64Codec()),
+		} {
+			if err := set.Verify(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}
+	})
+
+	// damaged keys, which do not start a block, are only detected by Verify
+	for _, test := range []struct {
+		name   string
+		damage func(data []byte) []byte
+	}{
+		{name: "suffix length", damage: set(33, 5)},
+		{name: "second key", damage: set(36, 'a')},
+		{name: "data after the last key", damage: func(data []byte) []byte { return putUint64(16, 2)(data[:len(data)-8]) }},
+	} {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			path := build(t, mapped.StringCodec(), 2, "a", "ab", "b")
+			damage(t, path, test.damage)
+
+			sortedSet := open(t, path, mapped.StringCodec())
+			if err := sortedSet.Verify(); !errors.Is(err, mapped.ErrFormat) {
+				t.Errorf("expected %v, got %v", mapped.ErrFormat, err)
+			}
+
+			// reading the damaged set must not panic
+			sortedSet.Contains("b")
+			cantor.NewHashSetFromIterator(sortedSet.Elements())
+		})
+	}
+
+	t.Run("index entry", func(t *testing.T) {
+		// the second block starts with key c at 38, but its index entry points to the sorted key b at 35
+		path := build(t, mapped.StringCodec(), 2, "a", "b", "c", "d")
+		damage(t, path, set(52, 35))
+
+		if err := open(t, path, mapped.StringCodec()).Verify(); !errors.Is(err, mapped.ErrFormat) {
+			t.Errorf("expected %v, got %v", mapped.ErrFormat, err)
+		}
+	})
+
+	t.Run("fixed width order", func(t *testing.T) {
+		path := build[uint64](t, mapped.Uint64Codec(), 1, 1, 2)
+		damage(t, path, set(39, 5))
+
+		if err := open(t, path, mapped.Uint64Codec()).Verify(); !errors.Is(err, mapped.ErrFormat) {
+			t.Errorf("expected %v, got %v", mapped.ErrFormat, err)
+		}
+	})
+}
+
+func putUint64(offset int, value uint64) func(data []byte) []byte {
+	return func(data []byte) []byte {
+		binary.LittleEndian.PutUint64(data[offset:], value)
+
+		return data
+	}
+}
+
+func set(offset int, value byte) func(data []byte) []byte {
+	return func(data []byte) []byte {
+		data[offset] = value
+
+		return data
+	}
+}
+
+func damage(t *testing.T, path string, modify func(data []byte) []byte) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = os.WriteFile(path, modify(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
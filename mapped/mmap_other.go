@@ -0,0 +1,26 @@
+//go:build !(aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris)
+
+package mapped
+
+import (
+	"fmt"
+	"os"
+)
+
+// mapFile reads the file at path into memory on platforms without support for memory-mapped files.
+func mapFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err == nil && len(data) < headerSize {
+		err = fmt.Errorf("%w: missing header", ErrFormat)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func unmapFile([]byte) error {
+	return nil
+}
@@ -0,0 +1,23 @@
+package cantor
+
+// [NewDifference] returns a [ReadableSet] with all elements of arg, which are not contained in other.
+// It can be used to implement [ReadableSet] outside of this package.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func NewDifference[T comparable](arg ReadableSet[T], other Container[T]) ReadableSet[T] {
+	return newIntersection[T](arg, negation[T]{arg: other})
+}
+
+// negation contains all elements, which are not contained in arg.
+// Unlike the closure of an [ImplicitSet], it keeps track of its operand.
+type negation[T comparable] struct {
+	arg Container[T]
+}
+
+func (set negation[T]) Contains(element T) bool {
+	return !set.arg.Contains(element)
+}
+
+func (set negation[T]) SafeForConcurrentReads() bool {
+	return safeForConcurrentReads[T](set.arg)
+}
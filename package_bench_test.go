@@ -52,6 +52,16 @@ func BenchmarkSet_IntoHashSet(b *testing.B) {
 	}
 }
 
+func BenchmarkEvaluateParallel(b *testing.B) {
+	set := buildUnionOfIntersectionsOfDifferences(2, 2, 100000)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = cantor.EvaluateParallel(set, 0)
+	}
+}
+
 // This function builds a fairly complicated expression of set operations which is build the following way:
 // The union of numberOfIntersections many intersections of numberOfIntersections many differences of two sets each,
 // which were constructed using numberOfRandomSamplesPerInput integers.
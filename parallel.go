@@ -0,0 +1,215 @@
+package cantor
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+const parallelBatchSize = 1024
+
+// [ErrNotConcurrencySafe] is returned by parallel operations,
+// if an operand does not guarantee to be safe for concurrent reads.
+var ErrNotConcurrencySafe = errors.New("cantor: not safe for concurrent reads")
+
+// [ConcurrentReader] is implemented by containers, which might be safe for concurrent reads.
+// Parallel operations like [EvaluateParallel] only accept operands, for which SafeForConcurrentReads returns true.
+//
+// [HashSet] and [ImplicitSet] are safe for concurrent reads, as long as they are not modified concurrently.
+// An [ImplicitSet] is considered safe, since its predicate must be deterministic and must not create side effects,
+// unless it was derived from operands, which are not safe.
+// Derived sets are safe, if all their operands are safe.
+type ConcurrentReader interface {
+	// SafeForConcurrentReads returns true, if all read operations can be called from multiple goroutines at once.
+	SafeForConcurrentReads() bool
+}
+
+// [EvaluateParallel] evaluates set into a new [HashSet] using the given number of worker goroutines.
+// If workers is not positive, [runtime.GOMAXPROCS] workers are used.
+//
+// Only the membership checks are parallelised: the elements of the operands driving the iteration
+// are enumerated serially by a single goroutine and handed to the workers in batches,
+// which run the membership checks against all other operands concurrently.
+// The speedup therefore depends on the cost of these checks rather than on the cost of the enumeration.
+// The partial results of the workers are merged into the result.
+//
+// If set or one of its operands does not implement [ConcurrentReader] or is not safe for concurrent reads,
+// [ErrNotConcurrencySafe] is returned. The underlying sets must not be modified during the evaluation.
+func EvaluateParallel[T comparable](set ReadableSet[T], workers int) (HashSet[T], error) {
+	var (
+		mutex   sync.Mutex
+		results []HashSet[T]
+	)
+
+	err := runParallel(set, workers, func() func(element T) {
+		result := NewHashSet[T]()
+
+		mutex.Lock()
+		results = append(results, result)
+		mutex.Unlock()
+
+		return func(element T) {
+			result[element] = struct{}{}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	merged := results[0]
+	for _, result := range results[1:] {
+		for element := range result {
+			merged[element] = struct{}{}
+		}
+	}
+
+	return merged, nil
+}
+
+// [ParallelSize] returns the size of set like [ReadableSet.Size],
+// but runs the membership checks concurrently like [EvaluateParallel].
+// No elements are stored during the evaluation.
+func ParallelSize[T comparable](set ReadableSet[T], workers int) (int, error) {
+	var (
+		mutex sync.Mutex
+		sizes []*int
+	)
+
+	err := runParallel(set, workers, func() func(element T) {
+		size := new(int)
+
+		mutex.Lock()
+		sizes = append(sizes, size)
+		mutex.Unlock()
+
+		return func(element T) {
+			*size++
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	result := 0
+	for _, size := range sizes {
+		result += *size
+	}
+
+	return result, nil
+}
+
+// parallelTask enumerates elements, of which only those contained in all filters are part of the result.
+// All tasks of a set yield disjoint elements.
+type parallelTask[T comparable] struct {
+	elements Iterator[T]
+	filters  []Container[T]
+}
+
+func (task *parallelTask[T]) matches(element T) bool {
+	for _, filter := range task.filters {
+		if !filter.Contains(element) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type parallelBatch[T comparable] struct {
+	task     *parallelTask[T]
+	elements []T
+}
+
+// parallelTasks splits the evaluation of a set into tasks.
+// The elements of a union are driven by each operand, excluding those contained in previous operands.
+// The elements of an intersection are driven by its first operand and filtered by all others.
+func parallelTasks[T comparable](set ReadableSet[T]) []parallelTask[T] {
+	switch set := set.(type) {
+	case union[T]:
+		tasks := make([]parallelTask[T], 0, len(set.args))
+
+		for i, arg := range set.args {
+			tasks = append(tasks, parallelTask[T]{
+				elements: arg.Elements(),
+				filters:  []Container[T]{negation[T]{arg: newUnion(set.args[:i]...)}},
+			})
+		}
+
+		return tasks
+	case intersection[T]:
+		return []parallelTask[T]{{elements: set.arg.Elements(), filters: set.args}}
+	default:
+		return []parallelTask[T]{{elements: set.Elements()}}
+	}
+}
+
+// runParallel evaluates set using the given number of workers.
+// Each worker is initialized by newWorker, which returns a function handling the elements of the set.
+func runParallel[T comparable](set ReadableSet[T], workers int, newWorker func() func(element T)) error {
+	if !safeForConcurrentReads[T](set) {
+		return fmt.Errorf("%w: %T", ErrNotConcurrencySafe, set)
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	var (
+		waitGroup sync.WaitGroup
+		batches   = make(chan parallelBatch[T], workers)
+	)
+
+	for i := 0; i < workers; i++ {
+		handle := newWorker()
+
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			for batch := range batches {
+				for _, element := range batch.elements {
+					if batch.task.matches(element) {
+						handle(element)
+					}
+				}
+			}
+		}()
+	}
+
+	tasks := parallelTasks(set)
+	for i := range tasks {
+		produceBatches(&tasks[i], batches)
+	}
+
+	close(batches)
+	waitGroup.Wait()
+
+	return nil
+}
+
+func produceBatches[T comparable](task *parallelTask[T], batches chan<- parallelBatch[T]) {
+	batch := make([]T, 0, parallelBatchSize)
+
+	task.elements(func(element T) (next bool) {
+		batch = append(batch, element)
+
+		if len(batch) == parallelBatchSize {
+			batches <- parallelBatch[T]{task: task, elements: batch}
+			batch = make([]T, 0, parallelBatchSize)
+		}
+
+		return true
+	})
+
+	if len(batch) > 0 {
+		batches <- parallelBatch[T]{task: task, elements: batch}
+	}
+}
+
+func safeForConcurrentReads[T comparable](container Container[T]) bool {
+	reader, ok := container.(ConcurrentReader)
+
+	return ok && reader.SafeForConcurrentReads()
+}
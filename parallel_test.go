@@ -0,0 +1,152 @@
+package cantor_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+// unsafeContainer does not implement cantor.ConcurrentReader.
+type unsafeContainer struct{}
+
+func (unsafeContainer) Contains(element int) bool {
+	return element%2 == 0
+}
+
+// unsafeSet hides the implementation of cantor.ConcurrentReader of the embedded set.
+type unsafeSet struct {
+	cantor.ReadableSet[int]
+}
+
+func buildParallelTestSets() map[string]cantor.ReadableSet[int] {
+	a := cantor.NewHashSet[int]()
+	b := cantor.NewHashSet[int]()
+	c := cantor.NewHashSet[int]()
+
+	for i := 0; i < 10000; i++ {
+		a.Add(i)
+		b.Add(i * 2)
+		c.Add(i * 3)
+	}
+
+	return map[string]cantor.ReadableSet[int]{
+		"empty":        cantor.NewHashSet[int](),
+		"hash set":     a,
+		"union":        a.Union(b).Union(c),
+		"intersection": a.Intersect(b).Intersect(c.Complement()),
+		"difference":   a.Difference(b).Difference(c),
+		"nested":       a.Difference(b).Union(b.Intersect(c)).SymmetricDifference(c),
+		"implicit": a.Intersect(cantor.NewImplicitSet(func(element int) bool {
+			return element%5 == 0
+		})),
+		"benchmark": buildUnionOfIntersectionsOfDifferences(2, 2, 10000),
+	}
+}
+
+func TestEvaluateParallel(t *testing.T) {
+	for name, set := range buildParallelTestSets() {
+		set := set
+
+		t.Run(name, func(t *testing.T) {
+			expected := cantor.NewHashSetFromIterator(set.Elements())
+
+			for _, workers := range []int{0, 1, 3, 8} {
+				actual, err := cantor.EvaluateParallel(set, workers)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if !actual.Equals(expected) {
+					t.Errorf("result with %d workers does not equal sequential evaluation", workers)
+				}
+			}
+		})
+	}
+
+	t.Run("not safe for concurrent reads", func(t *testing.T) {
+		set := cantor.NewHashSet(1, 2, 3)
+		unsafe := []cantor.ReadableSet[int]{
+			set.Intersect(unsafeContainer{}),
+			set.Difference(unsafeContainer{}),
+			set.Union(set.Difference(unsafeContainer{})),
+			set.Difference(unsafeContainer{}).Intersect(set),
+			set.Union(unsafeSet{set}).Intersect(set),
+			unsafeSet{set},
+		}
+
+		for _, view := range unsafe {
+			_, err := cantor.EvaluateParallel(view, 2)
+			if !errors.Is(err, cantor.ErrNotConcurrencySafe) {
+				t.Errorf("expected ErrNotConcurrencySafe but got %v", err)
+			}
+		}
+	})
+}
+
+func TestEvaluateParallel_implicitSets(t *testing.T) {
+	set := cantor.NewHashSet(1, 2, 3)
+	implicit := cantor.NewImplicitSet(func(element int) bool {
+		return element > 1
+	})
+
+	for name, operator := range map[string]func(other cantor.Container[int]) cantor.ImplicitSet[int]{
+		"Union":               implicit.Union,
+		"Intersect":           implicit.Intersect,
+		"Difference":          implicit.Difference,
+		"SymmetricDifference": implicit.SymmetricDifference,
+		"Complement": func(other cantor.Container[int]) cantor.ImplicitSet[int] {
+			return implicit.Intersect(other).Complement()
+		},
+		"Complement of a derived set": func(other cantor.Container[int]) cantor.ImplicitSet[int] {
+			return cantor.NewHashSet(1, 2).Intersect(other).Complement().Complement()
+		},
+	} {
+		operator := operator
+
+		t.Run(name, func(t *testing.T) {
+			_, err := cantor.EvaluateParallel(set.Intersect(operator(unsafeContainer{})), 2)
+			if !errors.Is(err, cantor.ErrNotConcurrencySafe) {
+				t.Errorf("expected ErrNotConcurrencySafe for an unsafe operand but got %v", err)
+			}
+
+			// the safe operand contains the same elements within 1 to 4
+			safe := operator(cantor.NewHashSet(2, 4))
+
+			_, err = cantor.EvaluateParallel(set.Intersect(safe), 2)
+			if err != nil {
+				t.Errorf("unexpected error for a safe operand: %v", err)
+			}
+
+			for element := 1; element <= 4; element++ {
+				if operator(unsafeContainer{}).Contains(element) != safe.Contains(element) {
+					t.Errorf("expected the marked set to keep its predicate for %d", element)
+				}
+			}
+		})
+	}
+}
+
+func TestParallelSize(t *testing.T) {
+	for name, set := range buildParallelTestSets() {
+		set := set
+
+		t.Run(name, func(t *testing.T) {
+			expected := set.Size()
+
+			for _, workers := range []int{0, 1, 3, 8} {
+				actual, err := cantor.ParallelSize(set, workers)
+				if err != nil || actual != expected {
+					t.Errorf("expected %d with %d workers but got %d (%v)", expected, workers, actual, err)
+				}
+			}
+		})
+	}
+
+	t.Run("not safe for concurrent reads", func(t *testing.T) {
+		_, err := cantor.ParallelSize(cantor.NewHashSet(1).Intersect(unsafeContainer{}), 2)
+		if !errors.Is(err, cantor.ErrNotConcurrencySafe) {
+			t.Errorf("expected ErrNotConcurrencySafe but got %v", err)
+		}
+	})
+}
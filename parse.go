@@ -0,0 +1,212 @@
+package cantor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// [ParseError] describes a failure to parse a set literal.
+type ParseError struct {
+	// Offset is the byte offset in the input, at which the error occurred.
+	Offset int
+	// Message describes the error.
+	Message string
+	// Err is the error returned by the element parser, if any.
+	Err error
+}
+
+// Error implements the error interface.
+func (err *ParseError) Error() string {
+	if err.Err != nil {
+		return fmt.Sprintf("cantor: parse error at offset %d: %s: %v", err.Offset, err.Message, err.Err)
+	}
+
+	return fmt.Sprintf("cantor: parse error at offset %d: %s", err.Offset, err.Message)
+}
+
+// Unwrap returns the error returned by the element parser, if any.
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
+
+// [ParseHashSet] parses a set literal as returned by the String method of sets in this package,
+// for example "{a, b, c}". Each element is converted using parseElem. Duplicate elements are deduplicated.
+//
+// Elements are separated by commas and surrounding whitespace is ignored.
+// Elements, which are empty, contain commas, braces, double quotes or surrounding whitespace,
+// must be quoted using Go syntax for double-quoted strings, for example "{\"a, b\", \"{c}\"}".
+//
+// If the input is invalid, a [*ParseError] holding the position of the error is returned.
+func ParseHashSet[T comparable](s string, parseElem func(string) (T, error)) (HashSet[T], error) {
+	parser := setParser{input: s}
+	result := NewHashSet[T]()
+
+	err := parser.parse(func(offset int, text string) error {
+		element, err := parseElem(text)
+		if err != nil {
+			return &ParseError{Offset: offset, Message: fmt.Sprintf("invalid element %q", text), Err: err}
+		}
+
+		result.Add(element)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+type setParser struct {
+	input    string
+	position int
+}
+
+func (parser *setParser) parse(handle func(offset int, text string) error) error {
+	parser.skipSpace()
+
+	err := parser.expect('{')
+	if err != nil {
+		return err
+	}
+
+	parser.skipSpace()
+
+	if parser.peek() == '}' {
+		return parser.end()
+	}
+
+	for {
+		err = parser.element(handle)
+		if err != nil {
+			return err
+		}
+
+		parser.skipSpace()
+
+		if parser.peek() == '}' {
+			return parser.end()
+		}
+
+		err = parser.expect(',')
+		if err != nil {
+			return parser.fail("expected ',' or '}'")
+		}
+
+		parser.skipSpace()
+	}
+}
+
+func (parser *setParser) element(handle func(offset int, text string) error) error {
+	start := parser.position
+
+	if parser.peek() == '"' {
+		text, err := parser.quoted()
+		if err != nil {
+			return err
+		}
+
+		return handle(start, text)
+	}
+
+	for parser.position < len(parser.input) && !strings.ContainsRune(`,{}"`, rune(parser.input[parser.position])) {
+		parser.position++
+	}
+
+	text := strings.TrimRightFunc(parser.input[start:parser.position], unicode.IsSpace)
+	if text == "" {
+		parser.position = start
+
+		return parser.fail("expected element")
+	}
+
+	return handle(start, text)
+}
+
+func (parser *setParser) quoted() (string, error) {
+	start := parser.position
+	parser.position++
+
+	for parser.position < len(parser.input) {
+		switch parser.input[parser.position] {
+		case '\\':
+			parser.position += 2
+		case '"':
+			parser.position++
+
+			text, err := strconv.Unquote(parser.input[start:parser.position])
+			if err != nil {
+				return "", &ParseError{Offset: start, Message: "invalid quoted element", Err: err}
+			}
+
+			return text, nil
+		default:
+			parser.position++
+		}
+	}
+
+	return "", &ParseError{Offset: start, Message: "unterminated quoted element"}
+}
+
+func (parser *setParser) end() error {
+	parser.position++
+	parser.skipSpace()
+
+	if parser.position < len(parser.input) {
+		return parser.fail("unexpected input after '}'")
+	}
+
+	return nil
+}
+
+func (parser *setParser) expect(char byte) error {
+	if parser.peek() != char {
+		return parser.fail(fmt.Sprintf("expected '%c'", char))
+	}
+
+	parser.position++
+
+	return nil
+}
+
+func (parser *setParser) peek() byte {
+	if parser.position >= len(parser.input) {
+		return 0
+	}
+
+	return parser.input[parser.position]
+}
+
+func (parser *setParser) skipSpace() {
+	for parser.position < len(parser.input) {
+		char, size := utf8.DecodeRuneInString(parser.input[parser.position:])
+		if !unicode.IsSpace(char) {
+			return
+		}
+
+		parser.position += size
+	}
+}
+
+func (parser *setParser) fail(message string) error {
+	if parser.position >= len(parser.input) {
+		message += ", got end of input"
+	}
+
+	return &ParseError{Offset: parser.position, Message: message}
+}
+
+// quoteElement quotes the string representation of an element if it could not be parsed back otherwise.
+func quoteElement(text string) string {
+	if text == "" ||
+		strings.ContainsAny(text, `,{}"`) ||
+		strings.TrimSpace(text) != text {
+		return strconv.Quote(text)
+	}
+
+	return text
+}
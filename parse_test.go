@@ -0,0 +1,146 @@
+package cantor_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+func parseString(text string) (string, error) {
+	return text, nil
+}
+
+func TestParseHashSet(t *testing.T) {
+	valid := map[string][]string{
+		"{}":                        {},
+		"  { }  ":                   {},
+		"{a}":                       {"a"},
+		"{a, b, c}":                 {"a", "b", "c"},
+		"{a,b ,  c}":                {"a", "b", "c"},
+		"{a, a}":                    {"a"},
+		"{hello world, x}":          {"hello world", "x"},
+		`{"a, b", "{c}", "\"d\""}`:  {"a, b", "{c}", `"d"`},
+		`{"", " padded "}`:          {"", " padded "},
+		`{"tab\there", "ü"}`:        {"tab\there", "ü"},
+		"{ a , b}":                  {"a", "b"},
+		`{"quoted", bare, "again"}`: {"quoted", "bare", "again"},
+	}
+
+	for input, expected := range valid {
+		input, expected := input, expected
+
+		t.Run(input, func(t *testing.T) {
+			actual, err := cantor.ParseHashSet(input, parseString)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !actual.Equals(cantor.NewHashSet(expected...)) {
+				t.Errorf("expected %v but got %v", expected, actual)
+			}
+		})
+	}
+
+	invalid := map[string]int{
+		"":              0,
+		"a, b":          0,
+		"{":             1,
+		"{a":            2,
+		"{a,}":          3,
+		"{,a}":          1,
+		"{a b c":        6,
+		"{a} b":         4,
+		"{a {b}}":       3,
+		`{a"b"}`:        2,
+		`{"a" b}`:       5,
+		`{"a}`:          1,
+		`{"a\"}`:        1,
+		`{"a\q"}`:       1,
+		"{\"a\nb\"}":    1,
+		"{a, b, {c}}":   7,
+		"{a, b, c} {d}": 10,
+	}
+
+	for input, offset := range invalid {
+		input, offset := input, offset
+
+		t.Run(input, func(t *testing.T) {
+			_, err := cantor.ParseHashSet(input, parseString)
+
+			var parseError *cantor.ParseError
+			if !errors.As(err, &parseError) {
+				t.Fatalf("expected a ParseError but got %v", err)
+			}
+
+			if parseError.Offset != offset {
+				t.Errorf("expected error at offset %d but got %v", offset, err)
+			}
+		})
+	}
+
+	t.Run("invalid element", func(t *testing.T) {
+		_, err := cantor.ParseHashSet("{1, x}", strconv.Atoi)
+
+		var parseError *cantor.ParseError
+		if !errors.As(err, &parseError) || parseError.Offset != 4 {
+			t.Fatalf("expected a ParseError at offset 4 but got %v", err)
+		}
+
+		if !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("expected the error to wrap strconv.ErrSyntax")
+		}
+
+		expected := `cantor: parse error at offset 4: invalid element "x": strconv.Atoi: parsing "x": invalid syntax`
+		if err.Error() != expected {
+			t.Errorf("unexpected message: %s", err)
+		}
+	})
+
+	t.Run("message", func(t *testing.T) {
+		_, err := cantor.ParseHashSet("{a", parseString)
+
+		expected := "cantor: parse error at offset 2: expected ',' or '}', got end of input"
+		if err == nil || err.Error() != expected {
+			t.Errorf("unexpected message: %v", err)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		expected := cantor.NewHashSet("", "a, b", "{c}", `"d"`, " e ", "f g", "ü")
+
+		actual, err := cantor.ParseHashSet(expected.String(), parseString)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !actual.Equals(expected) {
+			t.Errorf("expected %v but got %v", expected, actual)
+		}
+	})
+
+	t.Run("round trip of integers", func(t *testing.T) {
+		expected := cantor.NewHashSet(-1, 0, 1, 42)
+
+		actual, err := cantor.ParseHashSet(expected.String(), strconv.Atoi)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !actual.Equals(expected) {
+			t.Errorf("expected %v but got %v", expected, actual)
+		}
+	})
+}
+
+func ExampleParseHashSet() {
+	set, err := cantor.ParseHashSet(`{admin, "editor, senior"}`, func(text string) (string, error) {
+		return text, nil
+	})
+
+	fmt.Println(set.Contains("editor, senior"), err)
+	// Output:
+	// true <nil>
+}
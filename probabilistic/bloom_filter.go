@@ -0,0 +1,144 @@
+package probabilistic
+
+import (
+	"math"
+	"math/bits"
+)
+
+// [BloomFilter] is a probabilistic [cantor.Container], which might contain elements that were never added.
+// The probability of such false positives is configured when creating the filter.
+// Elements, which were added, are always contained. Elements can not be removed.
+//
+// The zero value is not usable. Use [NewBloomFilter] to create a [BloomFilter].
+// A [BloomFilter] is safe for concurrent reads, as long as it is not modified concurrently.
+type BloomFilter[T any] struct {
+	bits   []uint64
+	size   uint64
+	hashes int
+	hasher Hasher[T]
+}
+
+// [NewBloomFilter] returns an empty [BloomFilter], which has the given false positive rate,
+// once the expected number of elements was added. If hasher is nil, a [Hasher] like [NewMapHasher]
+// is used, whose seed is shared by all filters of this process, so that they can be combined.
+//
+// It panics, if falsePositiveRate is not between 0 and 1.
+func NewBloomFilter[T comparable](expected int, falsePositiveRate float64, hasher Hasher[T]) *BloomFilter[T] {
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		panic("probabilistic: false positive rate must be between 0 and 1")
+	}
+
+	if hasher == nil {
+		hasher = defaultHasher[T]()
+	}
+
+	if expected < 1 {
+		expected = 1
+	}
+
+	size := uint64(math.Ceil(-float64(expected) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := int(math.Round(float64(size) / float64(expected) * math.Ln2))
+
+	if hashes < 1 {
+		hashes = 1
+	}
+
+	return &BloomFilter[T]{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+		hasher: hasher,
+	}
+}
+
+// Add adds the element to the filter.
+//
+// The time complexity of this method is O(k), where k is the number of hash functions.
+func (filter *BloomFilter[T]) Add(element T) {
+	filter.positions(element, func(position uint64) bool {
+		filter.bits[position/64] |= 1 << (position % 64)
+
+		return true
+	})
+}
+
+// Contains returns true, if the element was added or in case of a false positive.
+//
+// The time complexity of this method is O(k), where k is the number of hash functions.
+func (filter *BloomFilter[T]) Contains(element T) bool {
+	contained := true
+
+	filter.positions(element, func(position uint64) bool {
+		contained = filter.bits[position/64]&(1<<(position%64)) != 0
+
+		return contained
+	})
+
+	return contained
+}
+
+// positions calls yield with the positions of the bits of the element until yield returns false.
+// The positions are derived from a single hash using double hashing.
+func (filter *BloomFilter[T]) positions(element T, yield func(position uint64) bool) {
+	hash := filter.hasher.Hash(element)
+	step := mix(hash) | 1
+
+	for i := 0; i < filter.hashes; i++ {
+		if !yield(hash % filter.size) {
+			return
+		}
+
+		hash += step
+	}
+}
+
+// FalsePositiveRate estimates the current probability of false positives based on the number of set bits.
+func (filter *BloomFilter[T]) FalsePositiveRate() float64 {
+	ones := 0
+	for _, word := range filter.bits {
+		ones += bits.OnesCount64(word)
+	}
+
+	return math.Pow(float64(ones)/float64(filter.size), float64(filter.hashes))
+}
+
+// Union returns a new [BloomFilter] containing all elements of both filters.
+// The filters must have been created with the same parameters and [Hasher], otherwise [ErrIncompatible] is returned.
+func (filter *BloomFilter[T]) Union(other *BloomFilter[T]) (*BloomFilter[T], error) {
+	return filter.combine(other, func(a, b uint64) uint64 { return a | b })
+}
+
+// Intersect returns a new [BloomFilter] containing all elements contained in both filters.
+// False positives of the result are at most as likely as false positives of each filter.
+// The filters must have been created with the same parameters and [Hasher], otherwise [ErrIncompatible] is returned.
+func (filter *BloomFilter[T]) Intersect(other *BloomFilter[T]) (*BloomFilter[T], error) {
+	return filter.combine(other, func(a, b uint64) uint64 { return a & b })
+}
+
+func (filter *BloomFilter[T]) combine(
+	other *BloomFilter[T],
+	operation func(a, b uint64) uint64,
+) (*BloomFilter[T], error) {
+	if filter.size != other.size || filter.hashes != other.hashes || !sameHasher(filter.hasher, other.hasher) {
+		return nil, ErrIncompatible
+	}
+
+	result := &BloomFilter[T]{
+		bits:   make([]uint64, len(filter.bits)),
+		size:   filter.size,
+		hashes: filter.hashes,
+		hasher: filter.hasher,
+	}
+
+	for i := range result.bits {
+		result.bits[i] = operation(filter.bits[i], other.bits[i])
+	}
+
+	return result, nil
+}
+
+// SafeForConcurrentReads returns true.
+// A [BloomFilter] is safe for concurrent reads, as long as it is not modified concurrently.
+func (filter *BloomFilter[T]) SafeForConcurrentReads() bool {
+	return true
+}
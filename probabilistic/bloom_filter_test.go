@@ -0,0 +1,177 @@
+package probabilistic_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/sets"
+	"github.com/frederik-jatzkowski/cantor/probabilistic"
+)
+
+func TestBloomFilter_Container(t *testing.T) {
+	sets.RunTestsForContainer(t, func(elements ...byte) cantor.Container[byte] {
+		// a tiny false positive rate makes false positives in the test suite practically impossible
+		filter := probabilistic.NewBloomFilter[byte](256, 1e-12, nil)
+		for _, element := range elements {
+			filter.Add(element)
+		}
+
+		return filter
+	})
+}
+
+func TestBloomFilter_falsePositiveRate(t *testing.T) {
+	for _, rate := range []float64{0.1, 0.01, 0.001} {
+		rate := rate
+
+		t.Run(fmt.Sprint(rate), func(t *testing.T) {
+			filter := probabilistic.NewBloomFilter[int](10000, rate, nil)
+			for i := 0; i < 10000; i++ {
+				filter.Add(i)
+			}
+
+			falsePositives := 0
+
+			for i := 10000; i < 110000; i++ {
+				if filter.Contains(i) {
+					falsePositives++
+				}
+			}
+
+			actual := float64(falsePositives) / 100000
+			if actual > 1.5*rate {
+				t.Errorf("expected a false positive rate of %v but got %v", rate, actual)
+			}
+
+			estimate := filter.FalsePositiveRate()
+			if estimate > 1.5*rate || estimate < rate/1.5 {
+				t.Errorf("expected an estimated false positive rate of %v but got %v", rate, estimate)
+			}
+		})
+	}
+}
+
+func TestNewBloomFilter(t *testing.T) {
+	for _, rate := range []float64{0, 1, -1, 2} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic for a false positive rate of %v", rate)
+				}
+			}()
+
+			probabilistic.NewBloomFilter[int](1, rate, nil)
+		}()
+	}
+
+	for _, expected := range []int{0, 100} {
+		filter := probabilistic.NewBloomFilter[int](expected, 0.9, nil)
+		filter.Add(1)
+
+		if !filter.Contains(1) {
+			t.Errorf("expected a filter for %d elements with a high false positive rate to work", expected)
+		}
+	}
+}
+
+func TestBloomFilter_Union(t *testing.T) {
+	hasher := probabilistic.NewMapHasher[int]()
+	a := probabilistic.NewBloomFilter[int](100, 1e-9, hasher)
+	b := probabilistic.NewBloomFilter[int](100, 1e-9, hasher)
+
+	for i := 0; i < 10; i++ {
+		a.Add(i)
+		b.Add(i + 5)
+	}
+
+	union, err := a.Union(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	intersection, err := a.Intersect(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i := 0; i < 20; i++ {
+		if union.Contains(i) != (i < 15) {
+			t.Errorf("unexpected result of Contains(%d) for the union", i)
+		}
+
+		if intersection.Contains(i) != (i >= 5 && i < 10) {
+			t.Errorf("unexpected result of Contains(%d) for the intersection", i)
+		}
+	}
+
+	if !union.SafeForConcurrentReads() {
+		t.Errorf("expected bloom filters to be safe for concurrent reads")
+	}
+
+	// filters without a hasher share the default hasher
+	_, err = probabilistic.NewBloomFilter[int](100, 0.01, nil).Union(probabilistic.NewBloomFilter[int](100, 0.01, nil))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestBloomFilter_incompatible(t *testing.T) {
+	hash := func(element int) uint64 { return uint64(element) }
+	hasher := multiplicative{}
+	filter := probabilistic.NewBloomFilter[int](100, 0.01, hasher)
+
+	compatible := map[string]*probabilistic.BloomFilter[int]{
+		"same hasher": probabilistic.NewBloomFilter[int](100, 0.01, multiplicative{}),
+	}
+
+	incompatible := map[string]*probabilistic.BloomFilter[int]{
+		"size":           probabilistic.NewBloomFilter[int](1000, 0.01, hasher),
+		"hashes":         probabilistic.NewBloomFilter[int](100, 0.0099, hasher),
+		"function":       probabilistic.NewBloomFilter[int](100, 0.01, probabilistic.HasherFunc[int](hasher.Hash)),
+		"other type":     probabilistic.NewBloomFilter[int](100, 0.01, probabilistic.NewMapHasher[int]()),
+		"not comparable": probabilistic.NewBloomFilter[int](100, 0.01, sliceHasher{}),
+		"default hasher": probabilistic.NewBloomFilter[int](100, 0.01, nil),
+	}
+
+	for name, other := range compatible {
+		_, err := filter.Union(other)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+	}
+
+	for name, other := range incompatible {
+		_, err := filter.Union(other)
+		if !errors.Is(err, probabilistic.ErrIncompatible) {
+			t.Errorf("%s: expected ErrIncompatible but got %v", name, err)
+		}
+	}
+
+	// closures of the same function can capture different state, so functions are never the same hasher
+	for name, hasher := range map[string]probabilistic.Hasher[int]{
+		"function":         probabilistic.HasherFunc[int](hash),
+		"not comparable":   sliceHasher{},
+		"wrapped function": wrappedHasher{Hasher: probabilistic.HasherFunc[int](hash)},
+	} {
+		filter := probabilistic.NewBloomFilter[int](1, 0.5, hasher)
+
+		_, err := filter.Intersect(filter)
+		if !errors.Is(err, probabilistic.ErrIncompatible) {
+			t.Errorf("%s: expected ErrIncompatible but got %v", name, err)
+		}
+	}
+}
+
+// wrappedHasher is comparable, but comparing it panics, if it holds a function.
+type wrappedHasher struct {
+	probabilistic.Hasher[int]
+}
+
+// sliceHasher is not comparable.
+type sliceHasher []int
+
+func (sliceHasher) Hash(element int) uint64 {
+	return uint64(element)
+}
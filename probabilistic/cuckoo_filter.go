@@ -0,0 +1,337 @@
+package probabilistic
+
+import (
+	"errors"
+	"math"
+)
+
+// [ErrFull] is returned, if an element can not be added to a [CuckooFilter], because it is full.
+var ErrFull = errors.New("probabilistic: filter is full")
+
+const (
+	bucketSize = 4
+	maxKicks   = 500
+)
+
+// [CuckooFilter] is a probabilistic [cantor.Container], which might contain elements that were never added.
+// The probability of such false positives is configured when creating the filter.
+// Unlike a [BloomFilter], elements can be removed.
+//
+// The filter stores a short fingerprint of each element. Adding an element twice stores two fingerprints,
+// so it has to be removed twice.
+//
+// The zero value is not usable. Use [NewCuckooFilter] to create a [CuckooFilter].
+// A [CuckooFilter] is safe for concurrent reads, as long as it is not modified concurrently.
+type CuckooFilter[T any] struct {
+	buckets [][bucketSize]uint32
+	mask    uint64
+	bits    int
+	hasher  Hasher[T]
+	// victim stores a fingerprint, which could not be placed into a bucket.
+	victim      uint32
+	victimIndex uint64
+	count       int
+	kicks       uint64
+}
+
+// [NewCuckooFilter] returns an empty [CuckooFilter] with room for at least the expected number of elements
+// and the given false positive rate. If hasher is nil, a [Hasher] like [NewMapHasher]
+// is used, whose seed is shared by all filters of this process, so that they can be combined.
+//
+// It panics, if falsePositiveRate is not between 0 and 1.
+func NewCuckooFilter[T comparable](expected int, falsePositiveRate float64, hasher Hasher[T]) *CuckooFilter[T] {
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		panic("probabilistic: false positive rate must be between 0 and 1")
+	}
+
+	if hasher == nil {
+		hasher = defaultHasher[T]()
+	}
+
+	// each lookup compares 2 * bucketSize fingerprints
+	bits := int(math.Ceil(math.Log2(2 * bucketSize / falsePositiveRate)))
+	if bits > 32 {
+		bits = 32
+	}
+
+	buckets := uint64(1)
+	for float64(buckets*bucketSize)*0.95 < float64(expected) {
+		buckets *= 2
+	}
+
+	return &CuckooFilter[T]{
+		buckets: make([][bucketSize]uint32, buckets),
+		mask:    buckets - 1,
+		bits:    bits,
+		hasher:  hasher,
+	}
+}
+
+// fingerprint returns the non-zero fingerprint and the first bucket index of an element.
+func (filter *CuckooFilter[T]) fingerprint(element T) (uint32, uint64) {
+	hash := filter.hasher.Hash(element)
+	fingerprint := uint32(mix(hash) >> (64 - filter.bits))
+
+	if fingerprint == 0 {
+		fingerprint = 1
+	}
+
+	return fingerprint, hash & filter.mask
+}
+
+// alternative returns the other bucket index of a fingerprint. It is its own inverse.
+func (filter *CuckooFilter[T]) alternative(index uint64, fingerprint uint32) uint64 {
+	return (index ^ mix(uint64(fingerprint))) & filter.mask
+}
+
+// Add adds the element to the filter. It returns [ErrFull], if there is no room left for the element.
+//
+// The amortized time complexity of this method is O(1).
+func (filter *CuckooFilter[T]) Add(element T) error {
+	fingerprint, index := filter.fingerprint(element)
+
+	return filter.insert(fingerprint, index)
+}
+
+func (filter *CuckooFilter[T]) insert(fingerprint uint32, index uint64) error {
+	if filter.victim != 0 {
+		return ErrFull
+	}
+
+	filter.count++
+
+	if filter.place(fingerprint, index) || filter.place(fingerprint, filter.alternative(index, fingerprint)) {
+		return nil
+	}
+
+	for kick := 0; kick < maxKicks; kick++ {
+		// evict a pseudo random fingerprint and move it to its alternative bucket
+		filter.kicks++
+		slot := filter.kicks % bucketSize
+		fingerprint, filter.buckets[index][slot] = filter.buckets[index][slot], fingerprint
+		index = filter.alternative(index, fingerprint)
+
+		if filter.place(fingerprint, index) {
+			return nil
+		}
+	}
+
+	filter.victim, filter.victimIndex = fingerprint, index
+
+	return nil
+}
+
+func (filter *CuckooFilter[T]) place(fingerprint uint32, index uint64) bool {
+	for slot, stored := range filter.buckets[index] {
+		if stored == 0 {
+			filter.buckets[index][slot] = fingerprint
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// Contains returns true, if the element was added and not removed or in case of a false positive.
+//
+// The time complexity of this method is O(1).
+func (filter *CuckooFilter[T]) Contains(element T) bool {
+	fingerprint, index := filter.fingerprint(element)
+
+	return filter.lookup(fingerprint, index)
+}
+
+func (filter *CuckooFilter[T]) lookup(fingerprint uint32, index uint64) bool {
+	alternative := filter.alternative(index, fingerprint)
+
+	if filter.victim == fingerprint && (filter.victimIndex == index || filter.victimIndex == alternative) {
+		return true
+	}
+
+	for slot := 0; slot < bucketSize; slot++ {
+		if filter.buckets[index][slot] == fingerprint || filter.buckets[alternative][slot] == fingerprint {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Remove removes the element once and returns true, if it was contained.
+// Only elements, which were added before, must be removed. Otherwise, the fingerprint of another
+// element could be removed, which results in false negatives.
+//
+// The time complexity of this method is O(1).
+func (filter *CuckooFilter[T]) Remove(element T) bool {
+	fingerprint, index := filter.fingerprint(element)
+
+	return filter.delete(fingerprint, index)
+}
+
+func (filter *CuckooFilter[T]) delete(fingerprint uint32, index uint64) bool {
+	alternative := filter.alternative(index, fingerprint)
+
+	if filter.victim == fingerprint && (filter.victimIndex == index || filter.victimIndex == alternative) {
+		filter.victim = 0
+		filter.count--
+
+		return true
+	}
+
+	for _, i := range [2]uint64{index, alternative} {
+		for slot := 0; slot < bucketSize; slot++ {
+			if filter.buckets[i][slot] == fingerprint {
+				filter.buckets[i][slot] = 0
+				filter.count--
+				filter.reinsertVictim()
+
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// reinsertVictim tries to move the victim into the space freed by a removal.
+func (filter *CuckooFilter[T]) reinsertVictim() {
+	if filter.victim == 0 {
+		return
+	}
+
+	fingerprint, index := filter.victim, filter.victimIndex
+	filter.victim = 0
+	filter.count--
+
+	_ = filter.insert(fingerprint, index)
+}
+
+// Count returns the number of stored fingerprints.
+func (filter *CuckooFilter[T]) Count() int {
+	return filter.count
+}
+
+// Union returns a new [CuckooFilter] containing the fingerprints of both filters.
+// Like [CuckooFilter.Add], fingerprints contained in both filters are stored twice,
+// so elements added to both filters have to be removed twice.
+// The filters must have been created with the same parameters and [Hasher], otherwise [ErrIncompatible] is returned.
+// If the result can not hold all fingerprints, [ErrFull] is returned.
+func (filter *CuckooFilter[T]) Union(other *CuckooFilter[T]) (*CuckooFilter[T], error) {
+	if !filter.compatible(other) {
+		return nil, ErrIncompatible
+	}
+
+	result := filter.clone()
+
+	err := other.fingerprints(result.insert)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Intersect returns a new [CuckooFilter] containing the fingerprints of this filter, which are also contained in other.
+// A fingerprint stored multiple times in both filters is stored as often as in the filter, which stores it less often.
+// The filters must have been created with the same parameters and [Hasher], otherwise [ErrIncompatible] is returned.
+func (filter *CuckooFilter[T]) Intersect(other *CuckooFilter[T]) (*CuckooFilter[T], error) {
+	if !filter.compatible(other) {
+		return nil, ErrIncompatible
+	}
+
+	counts := map[fingerprintKey]int{}
+
+	_ = other.fingerprints(func(fingerprint uint32, index uint64) error {
+		counts[other.key(fingerprint, index)]++
+
+		return nil
+	})
+
+	result := filter.clone()
+
+	// fingerprints are removed in place, so the result can hold all remaining fingerprints
+	for index := range result.buckets {
+		for slot, fingerprint := range result.buckets[index] {
+			if fingerprint != 0 && !result.retain(counts, fingerprint, uint64(index)) {
+				result.buckets[index][slot] = 0
+			}
+		}
+	}
+
+	if result.victim != 0 && !result.retain(counts, result.victim, result.victimIndex) {
+		result.victim = 0
+	}
+
+	return result, nil
+}
+
+// fingerprintKey identifies the copies of a fingerprint, which can be stored in the same pair of buckets.
+type fingerprintKey struct {
+	fingerprint uint32
+	index       uint64
+}
+
+// key returns the key of a fingerprint stored in the bucket with the index, which is the same for both buckets.
+func (filter *CuckooFilter[T]) key(fingerprint uint32, index uint64) fingerprintKey {
+	if alternative := filter.alternative(index, fingerprint); alternative < index {
+		index = alternative
+	}
+
+	return fingerprintKey{fingerprint: fingerprint, index: index}
+}
+
+// retain returns true and uses up a copy of the fingerprint in counts, if there is one left.
+// Otherwise, the fingerprint is not counted anymore.
+func (filter *CuckooFilter[T]) retain(counts map[fingerprintKey]int, fingerprint uint32, index uint64) bool {
+	key := filter.key(fingerprint, index)
+	if counts[key] > 0 {
+		counts[key]--
+
+		return true
+	}
+
+	filter.count--
+
+	return false
+}
+
+func (filter *CuckooFilter[T]) compatible(other *CuckooFilter[T]) bool {
+	return len(filter.buckets) == len(other.buckets) && filter.bits == other.bits &&
+		sameHasher(filter.hasher, other.hasher)
+}
+
+func (filter *CuckooFilter[T]) clone() *CuckooFilter[T] {
+	result := *filter
+	result.buckets = append([][bucketSize]uint32(nil), filter.buckets...)
+
+	return &result
+}
+
+// fingerprints calls yield with all stored fingerprints and their bucket index until yield returns an error.
+func (filter *CuckooFilter[T]) fingerprints(yield func(fingerprint uint32, index uint64) error) error {
+	for index, bucket := range filter.buckets {
+		for _, fingerprint := range bucket {
+			if fingerprint == 0 {
+				continue
+			}
+
+			err := yield(fingerprint, uint64(index))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if filter.victim != 0 {
+		return yield(filter.victim, filter.victimIndex)
+	}
+
+	return nil
+}
+
+// SafeForConcurrentReads returns true.
+// A [CuckooFilter] is safe for concurrent reads, as long as it is not modified concurrently.
+func (filter *CuckooFilter[T]) SafeForConcurrentReads() bool {
+	return true
+}
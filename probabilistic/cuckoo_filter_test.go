@@ -0,0 +1,277 @@
+package probabilistic_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/sets"
+	"github.com/frederik-jatzkowski/cantor/probabilistic"
+)
+
+func TestCuckooFilter_Container(t *testing.T) {
+	sets.RunTestsForContainer(t, func(elements ...byte) cantor.Container[byte] {
+		filter := probabilistic.NewCuckooFilter[byte](256, 1e-9, nil)
+		for _, element := range elements {
+			err := filter.Add(element)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		return filter
+	})
+}
+
+func TestCuckooFilter_falsePositiveRate(t *testing.T) {
+	for _, rate := range []float64{0.1, 0.01, 0.001} {
+		rate := rate
+
+		t.Run(fmt.Sprint(rate), func(t *testing.T) {
+			filter := probabilistic.NewCuckooFilter[int](10000, rate, nil)
+			for i := 0; i < 10000; i++ {
+				err := filter.Add(i)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			falsePositives := 0
+
+			for i := 10000; i < 110000; i++ {
+				if filter.Contains(i) {
+					falsePositives++
+				}
+			}
+
+			actual := float64(falsePositives) / 100000
+			if actual > 1.5*rate {
+				t.Errorf("expected a false positive rate of %v but got %v", rate, actual)
+			}
+		})
+	}
+}
+
+func TestNewCuckooFilter(t *testing.T) {
+	for _, rate := range []float64{0, 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic for a false positive rate of %v", rate)
+				}
+			}()
+
+			probabilistic.NewCuckooFilter[int](1, rate, nil)
+		}()
+	}
+}
+
+func TestCuckooFilter_Remove(t *testing.T) {
+	filter := probabilistic.NewCuckooFilter[int](100, 1e-9, nil)
+
+	for _, element := range []int{1, 2, 2} {
+		err := filter.Add(element)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if !filter.Remove(2) || !filter.Contains(2) || filter.Count() != 2 {
+		t.Errorf("expected 2 to be contained until it is removed twice")
+	}
+
+	if !filter.Remove(2) || filter.Contains(2) || filter.Remove(2) || filter.Count() != 1 {
+		t.Errorf("expected 2 to be removed")
+	}
+
+	if !filter.Contains(1) || !filter.SafeForConcurrentReads() {
+		t.Errorf("expected 1 to be contained")
+	}
+}
+
+func TestCuckooFilter_full(t *testing.T) {
+	// a filter for a single element has a single bucket with four slots, the fifth element becomes the victim
+	filter := probabilistic.NewCuckooFilter[int](1, 1e-9, nil)
+
+	for i := 1; i <= 5; i++ {
+		err := filter.Add(i)
+		if err != nil {
+			t.Fatalf("unexpected error for %d: %v", i, err)
+		}
+	}
+
+	err := filter.Add(6)
+	if !errors.Is(err, probabilistic.ErrFull) || filter.Count() != 5 {
+		t.Fatalf("expected ErrFull but got %v", err)
+	}
+
+	for i := 1; i <= 5; i++ {
+		if !filter.Contains(i) {
+			t.Errorf("expected %d to be contained", i)
+		}
+	}
+
+	// removing the victim frees space for a new victim
+	if !filter.Remove(5) || filter.Contains(5) || filter.Add(5) != nil {
+		t.Fatalf("expected the victim to be replaceable")
+	}
+
+	// removing an element from the bucket moves the victim into the bucket
+	if !filter.Remove(1) || filter.Contains(1) || filter.Count() != 4 || filter.Add(6) != nil {
+		t.Fatalf("expected the victim to be moved into the bucket")
+	}
+
+	for i := 2; i <= 6; i++ {
+		if !filter.Contains(i) {
+			t.Errorf("expected %d to be contained", i)
+		}
+	}
+}
+
+func TestCuckooFilter_Union(t *testing.T) {
+	hasher := probabilistic.NewMapHasher[int]()
+	a := probabilistic.NewCuckooFilter[int](100, 1e-9, hasher)
+	b := probabilistic.NewCuckooFilter[int](100, 1e-9, hasher)
+
+	for i := 0; i < 10; i++ {
+		_ = a.Add(i)
+		_ = b.Add(i + 5)
+	}
+
+	union, err := a.Union(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	intersection, err := a.Intersect(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i := 0; i < 20; i++ {
+		if union.Contains(i) != (i < 15) {
+			t.Errorf("unexpected result of Contains(%d) for the union", i)
+		}
+
+		if intersection.Contains(i) != (i >= 5 && i < 10) {
+			t.Errorf("unexpected result of Contains(%d) for the intersection", i)
+		}
+	}
+
+	if union.Count() != 20 || intersection.Count() != 5 || a.Count() != 10 {
+		t.Errorf("unexpected counts %d and %d", union.Count(), intersection.Count())
+	}
+
+	if !a.Remove(0) || !union.Contains(0) {
+		t.Errorf("expected the union to be independent of its operands")
+	}
+}
+
+func TestCuckooFilter_Intersect_multiset(t *testing.T) {
+	// a fixed hasher and long fingerprints rule out collisions
+	hasher := multiplicative{}
+	a := probabilistic.NewCuckooFilter[int](100, 1e-9, hasher)
+	b := probabilistic.NewCuckooFilter[int](100, 1e-9, hasher)
+
+	for _, element := range []int{0, 0, 0, 1} {
+		_ = a.Add(element)
+	}
+
+	for _, element := range []int{0, 0, 2} {
+		_ = b.Add(element)
+	}
+
+	union, err := a.Union(b)
+	if err != nil || union.Count() != 7 {
+		t.Fatalf("expected the union to store all 7 fingerprints but got %d (%v)", union.Count(), err)
+	}
+
+	intersection, err := a.Intersect(b)
+	if err != nil || intersection.Count() != 2 {
+		t.Fatalf("expected the intersection to store 0 twice but got %d (%v)", intersection.Count(), err)
+	}
+
+	for name, test := range map[string]struct {
+		filter  *probabilistic.CuckooFilter[int]
+		removes int
+	}{
+		"Union":     {filter: union, removes: 5},
+		"Intersect": {filter: intersection, removes: 2},
+	} {
+		for i := 0; i < test.removes; i++ {
+			if !test.filter.Remove(0) {
+				t.Errorf("%s: expected 0 to be stored %d times but got %d", name, test.removes, i)
+			}
+		}
+
+		if test.filter.Contains(0) {
+			t.Errorf("%s: expected 0 to be stored %d times", name, test.removes)
+		}
+	}
+
+	if intersection.Contains(1) || intersection.Contains(2) || !union.Contains(1) || !union.Contains(2) {
+		t.Errorf("unexpected elements")
+	}
+}
+
+func TestCuckooFilter_Union_victims(t *testing.T) {
+	hasher := probabilistic.NewMapHasher[int]()
+	a := probabilistic.NewCuckooFilter[int](1, 1e-9, hasher)
+	b := probabilistic.NewCuckooFilter[int](1, 1e-9, hasher)
+
+	for i := 1; i <= 5; i++ {
+		_ = a.Add(i)
+		_ = b.Add(i + 2)
+	}
+
+	_, err := a.Union(b)
+	if !errors.Is(err, probabilistic.ErrFull) {
+		t.Errorf("expected ErrFull but got %v", err)
+	}
+
+	union, err := probabilistic.NewCuckooFilter[int](1, 1e-9, hasher).Union(b)
+	if err != nil || union.Count() != 5 || !union.Contains(7) {
+		t.Errorf("expected the victim to be part of the union but got %v", err)
+	}
+
+	intersection, err := a.Intersect(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i := 1; i <= 7; i++ {
+		if intersection.Contains(i) != (i >= 3 && i <= 5) {
+			t.Errorf("unexpected result of Contains(%d) for the intersection", i)
+		}
+	}
+
+	other := probabilistic.NewCuckooFilter[int](1, 1e-9, hasher)
+	_ = other.Add(1)
+
+	intersection, _ = a.Intersect(other)
+	if intersection.Count() != 1 || !intersection.Contains(1) || intersection.Contains(5) {
+		t.Errorf("expected the victim to be removed from the intersection")
+	}
+}
+
+func TestCuckooFilter_incompatible(t *testing.T) {
+	hasher := probabilistic.NewMapHasher[int]()
+	filter := probabilistic.NewCuckooFilter[int](100, 0.01, hasher)
+
+	for name, other := range map[string]*probabilistic.CuckooFilter[int]{
+		"size":        probabilistic.NewCuckooFilter[int](1000, 0.01, hasher),
+		"fingerprint": probabilistic.NewCuckooFilter[int](100, 0.0001, hasher),
+		"hasher":      probabilistic.NewCuckooFilter[int](100, 0.01, nil),
+	} {
+		_, err := filter.Union(other)
+		if !errors.Is(err, probabilistic.ErrIncompatible) {
+			t.Errorf("%s: expected ErrIncompatible but got %v", name, err)
+		}
+
+		_, err = filter.Intersect(other)
+		if !errors.Is(err, probabilistic.ErrIncompatible) {
+			t.Errorf("%s: expected ErrIncompatible but got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package probabilistic_test
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/probabilistic"
+)
+
+// Filters can be used as cheap prefilters before expensive lookups.
+func ExampleBloomFilter() {
+	lookups := 0
+	premium := cantor.NewImplicitSet(func(user string) bool {
+		lookups++ // for example a database query
+
+		return user == "alice"
+	})
+
+	filter := probabilistic.NewBloomFilter[string](1000, 1e-6, nil)
+	filter.Add("alice")
+	filter.Add("bob")
+
+	users := cantor.NewHashSet("alice", "bob", "charles", "dave", "eve")
+	candidates := users.Intersect(filter).Intersect(premium)
+
+	fmt.Println(candidates)
+	fmt.Println(lookups)
+	// Output:
+	// {alice}
+	// 2
+}
+
+// Unlike bloom filters, cuckoo filters support the removal of elements.
+func ExampleCuckooFilter() {
+	sessions := probabilistic.NewCuckooFilter[int](1000, 0.001, nil)
+
+	_ = sessions.Add(42)
+	fmt.Println(sessions.Contains(42))
+
+	sessions.Remove(42)
+	fmt.Println(sessions.Contains(42))
+	// Output:
+	// true
+	// false
+}
+
+// Sketches estimate the number of distinct elements with a fixed amount of memory.
+func ExampleSketch() {
+	hasher := probabilistic.NewMapHasher[int]()
+	monday := probabilistic.NewSketch(14, hasher)
+	tuesday := probabilistic.NewSketch(14, hasher)
+
+	for visitor := 0; visitor < 100000; visitor++ {
+		monday.Add(visitor)
+		tuesday.Add(visitor + 50000)
+	}
+
+	both, _ := probabilistic.EstimateIntersection(monday, tuesday)
+
+	fmt.Println(math.Abs(float64(monday.Estimate())-100000) < 2000)
+	fmt.Println(math.Abs(float64(both)-50000) < 5000)
+	// Output:
+	// true
+	// true
+}
+
+// An index of signatures finds near duplicates without comparing all pairs of documents.
+func ExampleLSHIndex() {
+	shingles := func(text string) cantor.Iterator[string] {
+		words := strings.Fields(text)
+
+		return func(yield func(element string) bool) {
+			for i := 0; i+2 <= len(words); i++ {
+				if !yield(strings.Join(words[i:i+2], " ")) {
+					return
+				}
+			}
+		}
+	}
+
+	hasher := probabilistic.NewMapHasher[string]()
+	index := probabilistic.NewLSHIndex[string, string](32, 2)
+	documents := map[string]string{
+		"a": "the quick brown fox jumps over the lazy dog near the river bank",
+		"b": "a slow green turtle walks under the old bridge in the rain",
+	}
+
+	for name, text := range documents {
+		_ = index.Insert(name, probabilistic.NewSignature(shingles(text), 64, hasher))
+	}
+
+	query := probabilistic.NewSignature(shingles("the quick brown fox jumps over the lazy dog near the river"), 64, hasher)
+	candidates, _ := index.Candidates(query)
+
+	fmt.Println(candidates)
+	// Output:
+	// {a}
+}
@@ -0,0 +1,190 @@
+// Package probabilistic implements space efficient data structures, which answer questions about sets approximately.
+//
+// [BloomFilter] and [CuckooFilter] implement [cantor.Container] with a configurable false positive rate.
+// They can be used as cheap prefilters before expensive lookups:
+//
+//	candidates := users.Intersect(filter).Intersect(expensiveLookup)
+//
+// Since intersections consult their operands in order, expensiveLookup is only consulted
+// for elements, which are probably contained in the filter.
+//
+// [Sketch] estimates the number of distinct elements of large sets and [Signature] estimates their similarity.
+// An [LSHIndex] of signatures finds near duplicates among many sets.
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// [ErrIncompatible] is returned when combining data structures, which differ in size or hashing.
+var ErrIncompatible = errors.New("probabilistic: incompatible parameters")
+
+// [Hasher] computes 64 bit hashes of elements. Equal elements must have equal hashes.
+//
+// Data structures can only be combined, if they use the same [Hasher], which means equal values of a comparable type.
+// For example, hashers of a struct type with a seed can be compared by their seeds.
+type Hasher[T any] interface {
+	Hash(element T) uint64
+}
+
+// [HasherFunc] implements [Hasher] using a function.
+// Since functions can not be compared, data structures using a [HasherFunc] can not be combined.
+type HasherFunc[T any] func(element T) uint64
+
+// Hash returns the result of calling hash with the element.
+func (hash HasherFunc[T]) Hash(element T) uint64 {
+	return hash(element)
+}
+
+// mapHasher hashes arbitrary comparable values using [maphash].
+// Map hashers are equal, if they share their seed.
+type mapHasher[T comparable] struct {
+	seed maphash.Seed
+}
+
+// defaultSeed is shared by all data structures created without a [Hasher] in this process.
+var defaultSeed = maphash.MakeSeed()
+
+// [NewMapHasher] returns a [Hasher] for any comparable type, which is based on [hash/maphash] with a random seed.
+// Values are hashed by their contents, like they are compared by ==. Pointers and channels are hashed by address.
+//
+// Since the seed differs between processes, hashes must not be persisted.
+func NewMapHasher[T comparable]() Hasher[T] {
+	return mapHasher[T]{seed: maphash.MakeSeed()}
+}
+
+// defaultHasher returns the [Hasher] used, if no hasher is given to a constructor.
+// It is like [NewMapHasher], but all default hashers of a process share their seed,
+// so that data structures created without a hasher can be combined.
+func defaultHasher[T comparable]() Hasher[T] {
+	return mapHasher[T]{seed: defaultSeed}
+}
+
+func (hasher mapHasher[T]) Hash(element T) uint64 {
+	var hash maphash.Hash
+
+	hash.SetSeed(hasher.seed)
+
+	switch element := any(element).(type) {
+	case string:
+		_, _ = hash.WriteString(element)
+	case int:
+		writeUint64(&hash, uint64(element))
+	case int64:
+		writeUint64(&hash, uint64(element))
+	case uint64:
+		writeUint64(&hash, element)
+	default:
+		writeValue(&hash, reflect.ValueOf(element))
+	}
+
+	return hash.Sum64()
+}
+
+func writeUint64(hash *maphash.Hash, value uint64) {
+	var buffer [8]byte
+
+	binary.LittleEndian.PutUint64(buffer[:], value)
+
+	_, _ = hash.Write(buffer[:])
+}
+
+func writeFloat(hash *maphash.Hash, value float64) {
+	if value == 0 {
+		// -0 and +0 are equal, but have different bits
+		value = 0
+	}
+
+	writeUint64(hash, math.Float64bits(value))
+}
+
+// writeValue writes the contents of a comparable value to hash.
+func writeValue(hash *maphash.Hash, value reflect.Value) {
+	switch value.Kind() {
+	case reflect.String:
+		writeUint64(hash, uint64(value.Len()))
+		_, _ = hash.WriteString(value.String())
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			writeValue(hash, value.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			writeValue(hash, value.Field(i))
+		}
+	case reflect.Interface:
+		writeInterface(hash, value)
+	default:
+		writeScalar(hash, value)
+	}
+}
+
+// writeScalar writes a boolean, a number or the address of a pointer or channel to hash.
+func writeScalar(hash *maphash.Hash, value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			_ = hash.WriteByte(1)
+		} else {
+			_ = hash.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(hash, uint64(value.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(hash, value.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(hash, value.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(hash, real(value.Complex()))
+		writeFloat(hash, imag(value.Complex()))
+	default:
+		// pointers, channels and unsafe pointers are compared by address
+		writeUint64(hash, uint64(value.Pointer()))
+	}
+}
+
+// writeInterface writes a marker for nil interfaces and the dynamic value otherwise.
+func writeInterface(hash *maphash.Hash, value reflect.Value) {
+	if value.IsNil() {
+		_ = hash.WriteByte(0)
+
+		return
+	}
+
+	_ = hash.WriteByte(1)
+
+	writeValue(hash, value.Elem())
+}
+
+// sameHasher returns true, if a and b are equal values of a comparable type.
+// Functions are never the same hasher, since closures of the same function can capture different state.
+func sameHasher[T any](a, b Hasher[T]) (same bool) {
+	typ := reflect.TypeOf(a)
+	if typ != reflect.TypeOf(b) || !typ.Comparable() {
+		return false
+	}
+
+	// comparing values with fields of interface types, which hold functions, panics
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+
+	return a == b
+}
+
+// mix scrambles the bits of a hash. It is used to derive independent hashes from a single hash.
+func mix(hash uint64) uint64 {
+	hash ^= hash >> 33
+	hash *= 0xff51afd7ed558ccd
+	hash ^= hash >> 33
+	hash *= 0xc4ceb9fe1a85ec53
+	hash ^= hash >> 33
+
+	return hash
+}
@@ -0,0 +1,87 @@
+package probabilistic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor/probabilistic"
+)
+
+type key struct {
+	Name    string
+	Weight  float64
+	Complex complex64
+	Flag    bool
+	Small   int8
+	Large   uint32
+	Array   [2]int16
+	Pointer *int
+	private string
+}
+
+func TestNewMapHasher(t *testing.T) {
+	t.Run("equal values", func(t *testing.T) {
+		hasher := probabilistic.NewMapHasher[key]()
+		pointer := new(int)
+
+		a := key{
+			Name: "a", Weight: 0, Complex: 1i, Flag: true, Small: -1, Large: 1,
+			Array: [2]int16{1, 2}, Pointer: pointer, private: "x",
+		}
+		b := a
+		b.Weight = math.Copysign(0, -1)
+
+		if hasher.Hash(a) != hasher.Hash(b) {
+			t.Errorf("expected equal values to have equal hashes")
+		}
+
+		for _, other := range []key{
+			{Name: "b"}, {Flag: true}, {Small: 1}, {Large: 1}, {Array: [2]int16{2, 1}},
+			{Pointer: new(int)}, {private: "x"}, {Complex: 1}, {Weight: 1},
+		} {
+			if hasher.Hash(key{}) == hasher.Hash(other) {
+				t.Errorf("expected different hashes for %v and the zero value", other)
+			}
+		}
+	})
+
+	t.Run("fast paths", func(t *testing.T) {
+		testDistinctHashes(t, probabilistic.NewMapHasher[string](), "a", "b", "")
+		testDistinctHashes(t, probabilistic.NewMapHasher[int](), 1, 2, -1)
+		testDistinctHashes(t, probabilistic.NewMapHasher[int64](), 1, 2, -1)
+		testDistinctHashes(t, probabilistic.NewMapHasher[uint64](), 1, 2, 3)
+	})
+
+	t.Run("seeds", func(t *testing.T) {
+		if probabilistic.NewMapHasher[string]().Hash("a") == probabilistic.NewMapHasher[string]().Hash("a") {
+			t.Errorf("expected different seeds to result in different hashes")
+		}
+	})
+}
+
+func testDistinctHashes[T comparable](t *testing.T, hasher probabilistic.Hasher[T], elements ...T) {
+	t.Helper()
+
+	seen := map[uint64]T{}
+
+	for _, element := range elements {
+		hash := hasher.Hash(element)
+		if hash != hasher.Hash(element) {
+			t.Errorf("expected hash of %v to be deterministic", element)
+		}
+
+		if previous, ok := seen[hash]; ok {
+			t.Errorf("expected different hashes for %v and %v", previous, element)
+		}
+
+		seen[hash] = element
+	}
+}
+
+func TestHasherFunc(t *testing.T) {
+	hasher := probabilistic.HasherFunc[int](func(element int) uint64 { return uint64(element) * 3 })
+
+	if hasher.Hash(2) != 6 {
+		t.Errorf("expected the result of the function")
+	}
+}
@@ -0,0 +1,217 @@
+package probabilistic
+
+import (
+	"math"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+// [Signature] is a MinHash signature of a set. The fraction of equal values in two signatures
+// estimates the Jaccard similarity of the underlying sets, see [cantor.Jaccard].
+//
+// The zero value is not usable. Use [NewSignature] to create a [Signature].
+type Signature[T any] struct {
+	hasher Hasher[T]
+	values []uint64
+}
+
+// [NewSignature] computes a [Signature] with the given number of values for all elements of the iterator.
+// The standard error of similarity estimates is about 1/sqrt(size).
+// If hasher is nil, a [Hasher] like [NewMapHasher] is used, whose seed is shared by all signatures of this process,
+// so that they can be compared.
+//
+// It panics, if size is less than 1.
+func NewSignature[T comparable](iterator cantor.Iterator[T], size int, hasher Hasher[T]) *Signature[T] {
+	if size < 1 {
+		panic("probabilistic: signature size must be at least 1")
+	}
+
+	if hasher == nil {
+		hasher = defaultHasher[T]()
+	}
+
+	signature := &Signature[T]{hasher: hasher, values: make([]uint64, size)}
+	for i := range signature.values {
+		signature.values[i] = math.MaxUint64
+	}
+
+	iterator(func(element T) bool {
+		hash := hasher.Hash(element)
+
+		for i, value := range signature.values {
+			// every position uses a different permutation of the hashes
+			permuted := mix(hash ^ mix(uint64(i)+1))
+			if permuted < value {
+				signature.values[i] = permuted
+			}
+		}
+
+		return true
+	})
+
+	return signature
+}
+
+// Size returns the number of values of the signature.
+func (signature *Signature[T]) Size() int {
+	return len(signature.values)
+}
+
+// Similarity estimates the Jaccard similarity of the sets represented by the signatures.
+// It returns [ErrIncompatible], if the signatures differ in size or [Hasher].
+//
+// The time complexity of this method is O(k), where k is the size of the signatures.
+func (signature *Signature[T]) Similarity(other *Signature[T]) (float64, error) {
+	if len(signature.values) != len(other.values) || !sameHasher(signature.hasher, other.hasher) {
+		return 0, ErrIncompatible
+	}
+
+	equal := 0
+
+	for i, value := range signature.values {
+		if value == other.values[i] {
+			equal++
+		}
+	}
+
+	return float64(equal) / float64(len(signature.values)), nil
+}
+
+// [LSHIndex] finds signatures, which are probably similar to a given signature, without comparing all of them.
+// Signatures are split into bands of rows and two signatures become candidates of each other,
+// if all rows of at least one band are equal. Sets with a similarity s become candidates
+// with the probability 1-(1-s^rows)^bands, which is 0.5 at about (1/bands)^(1/rows).
+//
+// The zero value is not usable. Use [NewLSHIndex] to create an [LSHIndex].
+type LSHIndex[K comparable, T any] struct {
+	bands      int
+	rows       int
+	hasher     Hasher[T]
+	buckets    []map[uint64][]K
+	signatures map[K]*Signature[T]
+}
+
+// [NewLSHIndex] returns an empty [LSHIndex] for signatures of size bands*rows.
+//
+// It panics, if bands or rows is less than 1.
+func NewLSHIndex[K comparable, T any](bands, rows int) *LSHIndex[K, T] {
+	if bands < 1 || rows < 1 {
+		panic("probabilistic: bands and rows must be at least 1")
+	}
+
+	buckets := make([]map[uint64][]K, bands)
+	for i := range buckets {
+		buckets[i] = map[uint64][]K{}
+	}
+
+	return &LSHIndex[K, T]{
+		bands:      bands,
+		rows:       rows,
+		buckets:    buckets,
+		signatures: map[K]*Signature[T]{},
+	}
+}
+
+// Insert adds the signature under the given key and replaces any signature previously inserted under this key.
+// It returns [ErrIncompatible], if the size of the signature is not bands*rows
+// or if it uses a different [Hasher] than previously inserted signatures.
+//
+// The time complexity of this method is O(k), where k is the size of the signature.
+func (index *LSHIndex[K, T]) Insert(key K, signature *Signature[T]) error {
+	err := index.compatible(signature)
+	if err != nil {
+		return err
+	}
+
+	index.Remove(key)
+
+	index.hasher = signature.hasher
+	index.signatures[key] = signature
+
+	for band, buckets := range index.buckets {
+		hash := index.bandHash(signature, band)
+		buckets[hash] = append(buckets[hash], key)
+	}
+
+	return nil
+}
+
+// Remove removes the signature inserted under the given key and returns true, if there was one.
+func (index *LSHIndex[K, T]) Remove(key K) bool {
+	signature, ok := index.signatures[key]
+	if !ok {
+		return false
+	}
+
+	delete(index.signatures, key)
+
+	for band, buckets := range index.buckets {
+		hash := index.bandHash(signature, band)
+		keys := buckets[hash]
+
+		for i := range keys {
+			if keys[i] == key {
+				keys[i] = keys[len(keys)-1]
+				keys = keys[:len(keys)-1]
+
+				break
+			}
+		}
+
+		if len(keys) == 0 {
+			delete(buckets, hash)
+		} else {
+			buckets[hash] = keys
+		}
+	}
+
+	return true
+}
+
+// Size returns the number of inserted signatures.
+func (index *LSHIndex[K, T]) Size() int {
+	return len(index.signatures)
+}
+
+// Candidates returns the keys of all inserted signatures, which share at least one band with the signature.
+// Candidates should be verified using [Signature.Similarity] or an exact comparison.
+// It returns an empty set and [ErrIncompatible] under the same conditions as [LSHIndex.Insert].
+func (index *LSHIndex[K, T]) Candidates(signature *Signature[T]) (cantor.HashSet[K], error) {
+	result := cantor.NewHashSet[K]()
+
+	err := index.compatible(signature)
+	if err != nil {
+		return result, err
+	}
+
+	for band, buckets := range index.buckets {
+		for _, key := range buckets[index.bandHash(signature, band)] {
+			result.Add(key)
+		}
+	}
+
+	return result, nil
+}
+
+func (index *LSHIndex[K, T]) compatible(signature *Signature[T]) error {
+	if len(signature.values) != index.bands*index.rows {
+		return ErrIncompatible
+	}
+
+	if index.hasher != nil && !sameHasher(index.hasher, signature.hasher) {
+		return ErrIncompatible
+	}
+
+	return nil
+}
+
+// bandHash hashes the rows of a band. Equal rows in different bands hash differently.
+func (index *LSHIndex[K, T]) bandHash(signature *Signature[T], band int) uint64 {
+	hash := mix(uint64(band) + 1)
+
+	for _, value := range signature.values[band*index.rows : (band+1)*index.rows] {
+		hash = mix(hash ^ value)
+	}
+
+	return hash
+}
@@ -0,0 +1,188 @@
+package probabilistic_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor/probabilistic"
+)
+
+func TestSignature_Similarity(t *testing.T) {
+	var hasher probabilistic.Hasher[int] = multiplicative{}
+
+	tests := map[string]struct {
+		a, b     *probabilistic.Signature[int]
+		expected float64
+	}{
+		"empty": {
+			a:        probabilistic.NewSignature(rangeIterator(0, 0), 256, hasher),
+			b:        probabilistic.NewSignature(rangeIterator(0, 0), 256, hasher),
+			expected: 1,
+		},
+		"equal": {
+			a:        probabilistic.NewSignature(rangeIterator(0, 1000), 256, hasher),
+			b:        probabilistic.NewSignature(rangeIterator(0, 1000), 256, hasher),
+			expected: 1,
+		},
+		"disjoint": {
+			a:        probabilistic.NewSignature(rangeIterator(0, 1000), 256, hasher),
+			b:        probabilistic.NewSignature(rangeIterator(1000, 2000), 256, hasher),
+			expected: 0,
+		},
+		"overlap": {
+			a:        probabilistic.NewSignature(rangeIterator(0, 1000), 256, hasher),
+			b:        probabilistic.NewSignature(rangeIterator(500, 1500), 256, hasher),
+			expected: 1.0 / 3,
+		},
+		"subset": {
+			a:        probabilistic.NewSignature(rangeIterator(0, 1000), 256, hasher),
+			b:        probabilistic.NewSignature(rangeIterator(0, 900), 256, hasher),
+			expected: 0.9,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			actual, err := test.a.Similarity(test.b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// the standard error is 1/16, so this allows for two standard errors
+			if math.Abs(actual-test.expected) > 0.125 {
+				t.Errorf("expected a similarity of about %v but got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNewSignature(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+
+	probabilistic.NewSignature(rangeIterator(0, 10), 0, nil)
+}
+
+func TestSignature_incompatible(t *testing.T) {
+	hasher := probabilistic.NewMapHasher[int]()
+	signature := probabilistic.NewSignature(rangeIterator(0, 10), 16, hasher)
+
+	tests := map[string]*probabilistic.Signature[int]{
+		"size":   probabilistic.NewSignature(rangeIterator(0, 10), 8, hasher),
+		"hasher": probabilistic.NewSignature(rangeIterator(0, 10), 16, nil),
+	}
+
+	for name, other := range tests {
+		other := other
+
+		t.Run(name, func(t *testing.T) {
+			_, err := signature.Similarity(other)
+			if !errors.Is(err, probabilistic.ErrIncompatible) {
+				t.Errorf("expected %v but got %v", probabilistic.ErrIncompatible, err)
+			}
+		})
+	}
+
+	if signature.Size() != 16 {
+		t.Errorf("expected size 16 but got %d", signature.Size())
+	}
+}
+
+func TestSignature_defaultHasher(t *testing.T) {
+	a := probabilistic.NewSignature(rangeIterator(0, 100), 64, nil)
+	b := probabilistic.NewSignature(rangeIterator(0, 100), 64, nil)
+
+	similarity, err := a.Similarity(b)
+	if err != nil || similarity != 1 {
+		t.Errorf("expected signatures without a hasher to be compatible but got %v (%v)", similarity, err)
+	}
+}
+
+func TestLSHIndex(t *testing.T) {
+	var hasher probabilistic.Hasher[int] = multiplicative{}
+
+	document := func(start int) *probabilistic.Signature[int] {
+		return probabilistic.NewSignature(rangeIterator(start, start+100), 100, hasher)
+	}
+
+	index := probabilistic.NewLSHIndex[int, int](20, 5)
+	for i := 0; i < 100; i++ {
+		if err := index.Insert(i, document(i*1000)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// the near duplicate has a similarity of 95/105 with document 3 and 0 with all other documents
+	candidates, err := index.Candidates(document(3005))
+	if err != nil || candidates.String() != "{3}" {
+		t.Errorf("expected candidates {3} but got %v (%v)", candidates, err)
+	}
+
+	// replacing a signature removes the old one
+	_ = index.Insert(3, document(3500))
+	_ = index.Insert(4, document(3500))
+
+	candidates, _ = index.Candidates(document(3005))
+	if candidates.Size() != 0 || index.Size() != 100 {
+		t.Errorf("expected no candidates but got %v", candidates)
+	}
+
+	if !index.Remove(4) || index.Remove(4) || index.Size() != 99 {
+		t.Error("expected document 4 to be removed once")
+	}
+
+	candidates, _ = index.Candidates(document(3500))
+	if candidates.String() != "{3}" {
+		t.Errorf("expected candidates {3} but got %v", candidates)
+	}
+}
+
+func TestLSHIndex_incompatible(t *testing.T) {
+	index := probabilistic.NewLSHIndex[string, int](4, 4)
+
+	err := index.Insert("a", probabilistic.NewSignature(rangeIterator(0, 10), 16, probabilistic.NewMapHasher[int]()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]*probabilistic.Signature[int]{
+		"size":   probabilistic.NewSignature(rangeIterator(0, 10), 8, nil),
+		"hasher": probabilistic.NewSignature(rangeIterator(0, 10), 16, nil),
+	}
+
+	for name, signature := range tests {
+		signature := signature
+
+		t.Run(name, func(t *testing.T) {
+			err := index.Insert("b", signature)
+			if !errors.Is(err, probabilistic.ErrIncompatible) {
+				t.Errorf("expected %v but got %v", probabilistic.ErrIncompatible, err)
+			}
+
+			candidates, err := index.Candidates(signature)
+			if !errors.Is(err, probabilistic.ErrIncompatible) || candidates.Size() != 0 {
+				t.Errorf("expected %v but got %v and %v", probabilistic.ErrIncompatible, candidates, err)
+			}
+		})
+	}
+}
+
+func TestNewLSHIndex(t *testing.T) {
+	for _, parameters := range [][2]int{{0, 1}, {1, 0}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic for %v", parameters)
+				}
+			}()
+
+			probabilistic.NewLSHIndex[int, int](parameters[0], parameters[1])
+		}()
+	}
+}
@@ -0,0 +1,453 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+const (
+	// sparsePrecision is the precision of the sparse representation of a [Sketch].
+	sparsePrecision = 25
+	sketchVersion   = 1
+	sparseMode      = 0
+	denseMode       = 1
+	// sketchHeaderSize is the size of the version, precision, mode and hasher identifier of an encoded [Sketch].
+	sketchHeaderSize = 11
+)
+
+// [Sketch] estimates the number of distinct elements using the HyperLogLog algorithm.
+// With precision p, it uses 2^p registers and has a relative standard error of about 1.04 / sqrt(2^p).
+//
+// Like in HyperLogLog++, small cardinalities are counted in a sparse representation with a higher precision,
+// which is converted into registers once it would need more memory.
+// Unlike HyperLogLog++, no empirical bias correction is applied, so the error is slightly larger
+// for cardinalities between about 2.5 and 5 times 2^p, where the estimator switches from linear counting.
+//
+// The zero value is not usable. Use [NewSketch] or [SketchOf] to create a [Sketch].
+type Sketch[T any] struct {
+	precision uint8
+	hasher    Hasher[T]
+	// sparse maps indices with sparsePrecision bits to their rank. It is nil in the dense representation.
+	sparse    map[uint32]uint8
+	registers []uint8
+}
+
+// [NewSketch] returns an empty [Sketch] with the given precision between 4 and 18.
+// If hasher is nil, a [Hasher] like [NewMapHasher] is used, whose seed is shared by all sketches of this process,
+// so that they can be merged.
+//
+// It panics, if the precision is out of range.
+func NewSketch[T comparable](precision int, hasher Hasher[T]) *Sketch[T] {
+	if precision < 4 || precision > 18 {
+		panic("probabilistic: precision must be between 4 and 18")
+	}
+
+	if hasher == nil {
+		hasher = defaultHasher[T]()
+	}
+
+	return &Sketch[T]{precision: uint8(precision), hasher: hasher, sparse: map[uint32]uint8{}}
+}
+
+// [SketchOf] returns a [Sketch] of all elements of the iterator. See [NewSketch] for the parameters.
+func SketchOf[T comparable](iterator cantor.Iterator[T], precision int, hasher Hasher[T]) *Sketch[T] {
+	sketch := NewSketch(precision, hasher)
+
+	iterator(func(element T) bool {
+		sketch.Add(element)
+
+		return true
+	})
+
+	return sketch
+}
+
+// Add adds the element to the sketch.
+//
+// The amortized time complexity of this method is O(1).
+func (sketch *Sketch[T]) Add(element T) {
+	hash := sketch.hasher.Hash(element)
+
+	if sketch.sparse == nil {
+		index, rank := hash>>(64-sketch.precision), rank(hash<<sketch.precision, 64-int(sketch.precision))
+		if rank > sketch.registers[index] {
+			sketch.registers[index] = rank
+		}
+
+		return
+	}
+
+	index, rank := uint32(hash>>(64-sparsePrecision)), rank(hash<<sparsePrecision, 64-sparsePrecision)
+	if rank > sketch.sparse[index] {
+		sketch.sparse[index] = rank
+	}
+
+	sketch.compact()
+}
+
+// rank returns the position of the first set bit of the given number of leading bits, starting at 1.
+func rank(bitsLeft uint64, width int) uint8 {
+	zeros := bits.LeadingZeros64(bitsLeft)
+	if zeros > width {
+		zeros = width
+	}
+
+	return uint8(zeros + 1)
+}
+
+// compact converts the sparse representation into registers, before it outgrows the dense encoding.
+func (sketch *Sketch[T]) compact() {
+	if len(sketch.sparse) > 1<<sketch.precision/8 {
+		sketch.toDense()
+	}
+}
+
+// toDense converts the sparse representation into registers.
+func (sketch *Sketch[T]) toDense() {
+	sketch.registers = make([]uint8, 1<<sketch.precision)
+	shift := sparsePrecision - sketch.precision
+
+	for sparseIndex, sparseRank := range sketch.sparse {
+		index := sparseIndex >> shift
+		rank := sparseRank + shift
+
+		// the bits between both precisions are the leading bits used for the rank
+		if remainder := sparseIndex & (1<<shift - 1); remainder != 0 {
+			rank = uint8(bits.LeadingZeros32(remainder)-(32-int(shift))) + 1
+		}
+
+		if rank > sketch.registers[index] {
+			sketch.registers[index] = rank
+		}
+	}
+
+	sketch.sparse = nil
+}
+
+// Estimate returns the estimated number of distinct elements added to the sketch.
+//
+// The time complexity of this method is O(2^p).
+func (sketch *Sketch[T]) Estimate() uint64 {
+	if sketch.sparse != nil {
+		return uint64(math.Round(linearCounting(1<<sparsePrecision, 1<<sparsePrecision-len(sketch.sparse))))
+	}
+
+	m := float64(len(sketch.registers))
+	sum, zeros := 0.0, 0
+
+	for _, register := range sketch.registers {
+		sum += math.Ldexp(1, -int(register))
+
+		if register == 0 {
+			zeros++
+		}
+	}
+
+	estimate := alpha(m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = linearCounting(int(m), zeros)
+	}
+
+	return uint64(math.Round(estimate))
+}
+
+func linearCounting(registers, zeros int) float64 {
+	return float64(registers) * math.Log(float64(registers)/float64(zeros))
+}
+
+func alpha(m float64) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/m)
+	}
+}
+
+// Merge adds all elements of other to this sketch.
+// The sketches must have the same precision and [Hasher], otherwise [ErrIncompatible] is returned.
+func (sketch *Sketch[T]) Merge(other *Sketch[T]) error {
+	if sketch.precision != other.precision || !sameHasher(sketch.hasher, other.hasher) {
+		return ErrIncompatible
+	}
+
+	if sketch.sparse != nil && other.sparse != nil {
+		sketch.mergeSparse(other.sparse)
+
+		return nil
+	}
+
+	other = other.dense()
+	if sketch.sparse != nil {
+		sketch.toDense()
+	}
+
+	for index, rank := range other.registers {
+		if rank > sketch.registers[index] {
+			sketch.registers[index] = rank
+		}
+	}
+
+	return nil
+}
+
+// mergeSparse merges the entries of another sparse sketch into this sparse sketch.
+func (sketch *Sketch[T]) mergeSparse(sparse map[uint32]uint8) {
+	for index, rank := range sparse {
+		if rank > sketch.sparse[index] {
+			sketch.sparse[index] = rank
+		}
+	}
+
+	sketch.compact()
+}
+
+// dense returns the sketch or a copy of it in the dense representation.
+func (sketch *Sketch[T]) dense() *Sketch[T] {
+	if sketch.sparse == nil {
+		return sketch
+	}
+
+	result := sketch.clone()
+	result.toDense()
+
+	return result
+}
+
+func (sketch *Sketch[T]) clone() *Sketch[T] {
+	result := &Sketch[T]{precision: sketch.precision, hasher: sketch.hasher}
+
+	if sketch.sparse != nil {
+		result.sparse = make(map[uint32]uint8, len(sketch.sparse))
+		for index, rank := range sketch.sparse {
+			result.sparse[index] = rank
+		}
+	} else {
+		result.registers = append([]uint8(nil), sketch.registers...)
+	}
+
+	return result
+}
+
+// Union returns a new [Sketch] of the elements of both sketches. See [Sketch.Merge] for the requirements.
+func (sketch *Sketch[T]) Union(other *Sketch[T]) (*Sketch[T], error) {
+	result := sketch.clone()
+
+	err := result.Merge(other)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// [EstimateIntersection] estimates the number of distinct elements added to both sketches
+// using the inclusion-exclusion principle |A ∩ B| = |A| + |B| - |A ∪ B|.
+// The absolute error depends on the size of the union, so the estimate is unreliable for small intersections.
+// See [Sketch.Merge] for the requirements.
+func EstimateIntersection[T any](a, b *Sketch[T]) (uint64, error) {
+	union, err := a.Union(b)
+	if err != nil {
+		return 0, err
+	}
+
+	sizeA, sizeB, sizeUnion := a.Estimate(), b.Estimate(), union.Estimate()
+
+	if sizeA+sizeB <= sizeUnion {
+		return 0, nil
+	}
+
+	intersection := sizeA + sizeB - sizeUnion
+	if intersection > sizeA {
+		intersection = sizeA
+	}
+
+	if intersection > sizeB {
+		intersection = sizeB
+	}
+
+	return intersection, nil
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+// Sparse sketches are encoded as delta encoded varints and dense sketches use 6 bits per register.
+//
+// Since hashes are encoded, the sketch can only be decoded and merged with sketches using the same [Hasher].
+// To detect other hashers, the encoding contains the hash of the zero value of T.
+// Sketches using the default [Hasher] or [NewMapHasher] can not be decoded in another process,
+// since their seed differs between processes.
+func (sketch *Sketch[T]) MarshalBinary() ([]byte, error) {
+	if sketch.sparse == nil {
+		data := sketch.appendHeader(make([]byte, 0, sketchHeaderSize+(len(sketch.registers)*6+7)/8), denseMode)
+
+		return append(data, packRegisters(sketch.registers)...), nil
+	}
+
+	entries := make([]uint64, 0, len(sketch.sparse))
+	for index, rank := range sketch.sparse {
+		entries = append(entries, uint64(index)<<6|uint64(rank))
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i] < entries[j] })
+
+	data := sketch.appendHeader(nil, sparseMode)
+	data = appendUvarint(data, uint64(len(entries)))
+	previous := uint64(0)
+
+	for _, entry := range entries {
+		data = appendUvarint(data, entry-previous)
+		previous = entry
+	}
+
+	return data, nil
+}
+
+func (sketch *Sketch[T]) appendHeader(data []byte, mode byte) []byte {
+	var hasherID [8]byte
+
+	binary.LittleEndian.PutUint64(hasherID[:], sketch.hasherID())
+
+	return append(append(data, sketchVersion, sketch.precision, mode), hasherID[:]...)
+}
+
+// hasherID identifies the [Hasher] of the sketch by the hash of the zero value.
+func (sketch *Sketch[T]) hasherID() uint64 {
+	var zero T
+
+	return sketch.hasher.Hash(zero)
+}
+
+func appendUvarint(data []byte, value uint64) []byte {
+	var buffer [binary.MaxVarintLen64]byte
+
+	return append(data, buffer[:binary.PutUvarint(buffer[:], value)]...)
+}
+
+func packRegisters(registers []uint8) []byte {
+	packed := make([]byte, (len(registers)*6+7)/8)
+
+	for i, register := range registers {
+		for bit := 0; bit < 6; bit++ {
+			if register&(1<<bit) != 0 {
+				position := i*6 + bit
+				packed[position/8] |= 1 << (position % 8)
+			}
+		}
+	}
+
+	return packed
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler] and replaces the contents of the sketch.
+// The [Hasher] of the sketch is kept, so it must be created by [NewSketch] with the [Hasher] of the encoded sketch.
+//
+// Invalid data results in an error wrapping [cantor.ErrInvalidEncoding].
+// If the data was encoded using another [Hasher], an error wrapping [ErrIncompatible] is returned.
+func (sketch *Sketch[T]) UnmarshalBinary(data []byte) error {
+	if !validSketchHeader(data) {
+		return fmt.Errorf("%w: invalid sketch header", cantor.ErrInvalidEncoding)
+	}
+
+	if binary.LittleEndian.Uint64(data[3:sketchHeaderSize]) != sketch.hasherID() {
+		return fmt.Errorf("%w: the sketch was encoded using another hasher", ErrIncompatible)
+	}
+
+	precision, mode, data := data[1], data[2], data[sketchHeaderSize:]
+
+	if mode == denseMode {
+		if len(data) != (6<<precision+7)/8 {
+			return fmt.Errorf("%w: invalid number of registers", cantor.ErrInvalidEncoding)
+		}
+
+		sketch.precision, sketch.sparse, sketch.registers = precision, nil, unpackRegisters(data, 1<<precision)
+
+		return nil
+	}
+
+	sparse, err := decodeSparse(data)
+	if err != nil {
+		return err
+	}
+
+	sketch.precision, sketch.sparse, sketch.registers = precision, sparse, nil
+	sketch.compact()
+
+	return nil
+}
+
+func validSketchHeader(data []byte) bool {
+	return len(data) >= sketchHeaderSize && data[0] == sketchVersion &&
+		data[1] >= 4 && data[1] <= 18 && data[2] <= denseMode
+}
+
+func unpackRegisters(packed []byte, count int) []uint8 {
+	registers := make([]uint8, count)
+
+	for i := range registers {
+		for bit := 0; bit < 6; bit++ {
+			position := i*6 + bit
+			if packed[position/8]&(1<<(position%8)) != 0 {
+				registers[i] |= 1 << bit
+			}
+		}
+	}
+
+	return registers
+}
+
+func decodeSparse(data []byte) (map[uint32]uint8, error) {
+	count, data, err := decodeCount(data)
+	if err != nil {
+		return nil, err
+	}
+
+	sparse := make(map[uint32]uint8, count)
+	entry := uint64(0)
+
+	for i := uint64(0); i < count; i++ {
+		delta, n := binary.Uvarint(data)
+		if n <= 0 || (i > 0 && delta == 0) {
+			return nil, fmt.Errorf("%w: invalid entry", cantor.ErrInvalidEncoding)
+		}
+
+		data = data[n:]
+		entry += delta
+
+		if !validEntry(entry) {
+			return nil, fmt.Errorf("%w: invalid entry", cantor.ErrInvalidEncoding)
+		}
+
+		sparse[uint32(entry>>6)] = uint8(entry & 63)
+	}
+
+	if len(data) > 0 {
+		return nil, fmt.Errorf("%w: trailing bytes", cantor.ErrInvalidEncoding)
+	}
+
+	return sparse, nil
+}
+
+// decodeCount decodes the number of entries of a sparse sketch and returns the remaining data.
+func decodeCount(data []byte) (uint64, []byte, error) {
+	count, n := binary.Uvarint(data)
+	// each entry needs at least one byte, which bounds the allocation for untrusted data
+	if n <= 0 || count > 1<<sparsePrecision || count > uint64(len(data)-n) {
+		return 0, nil, fmt.Errorf("%w: invalid number of entries", cantor.ErrInvalidEncoding)
+	}
+
+	return count, data[n:], nil
+}
+
+// validEntry returns true, if the entry consists of a sparse index and a rank, which is possible at sparse precision.
+func validEntry(entry uint64) bool {
+	return entry>>6 < 1<<sparsePrecision && entry&63 != 0 && entry&63 <= 64-sparsePrecision+1
+}
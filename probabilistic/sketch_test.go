@@ -0,0 +1,305 @@
+package probabilistic_test
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/probabilistic"
+)
+
+var _ encoding.BinaryMarshaler = &probabilistic.Sketch[int]{}
+
+func rangeIterator(from, to int) cantor.Iterator[int] {
+	return func(yield func(element int) bool) {
+		for i := from; i < to; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// assertEstimate checks the estimate with a relative tolerance.
+func assertEstimate(t *testing.T, expected int, actual uint64, tolerance float64) {
+	t.Helper()
+
+	assertWithin(t, expected, actual, tolerance*float64(expected)+1)
+}
+
+func assertWithin(t *testing.T, expected int, actual uint64, delta float64) {
+	t.Helper()
+
+	if math.Abs(float64(actual)-float64(expected)) > delta {
+		t.Errorf("expected an estimate of %d but got %d", expected, actual)
+	}
+}
+
+// multiplicative hashes integers deterministically.
+type multiplicative struct{}
+
+func (multiplicative) Hash(element int) uint64 {
+	return uint64(element) * 0x9e3779b97f4a7c15
+}
+
+// splitMix is a well distributed, but fixed hasher, so that the estimates do not depend on a random seed.
+type splitMix struct{}
+
+func (splitMix) Hash(element int) uint64 {
+	hash := uint64(element) + 0x9e3779b97f4a7c15
+	hash = (hash ^ hash>>30) * 0xbf58476d1ce4e5b9
+	hash = (hash ^ hash>>27) * 0x94d049bb133111eb
+
+	return hash ^ hash>>31
+}
+
+func TestSketch_Estimate(t *testing.T) {
+	var hasher probabilistic.Hasher[int] = splitMix{}
+
+	for _, precision := range []int{4, 5, 6, 10, 14} {
+		// the standard error is 1.04 / sqrt(2^p), the tolerance allows for 4 standard errors
+		tolerance := 4 * 1.04 / math.Sqrt(float64(int(1)<<precision))
+
+		for _, cardinality := range []int{0, 1, 10, 100, 1000, 10000, 200000} {
+			precision, cardinality := precision, cardinality
+
+			t.Run(fmt.Sprintf("%d/%d", precision, cardinality), func(t *testing.T) {
+				sketch := probabilistic.SketchOf(rangeIterator(0, cardinality), precision, hasher)
+
+				// duplicates do not change the estimate
+				rangeIterator(0, cardinality)(func(element int) bool {
+					sketch.Add(element)
+
+					return element < 100
+				})
+
+				assertEstimate(t, cardinality, sketch.Estimate(), tolerance)
+			})
+		}
+	}
+}
+
+func TestNewSketch(t *testing.T) {
+	for _, precision := range []int{3, 19} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic for precision %d", precision)
+				}
+			}()
+
+			probabilistic.NewSketch[int](precision, nil)
+		}()
+	}
+}
+
+func TestSketch_Merge(t *testing.T) {
+	hasher := probabilistic.NewMapHasher[int]()
+
+	tests := map[string]struct {
+		a, b   [2]int
+		union  int
+		shared int
+	}{
+		"sparse and sparse": {a: [2]int{0, 100}, b: [2]int{50, 150}, union: 150, shared: 50},
+		"sparse and dense":  {a: [2]int{0, 100}, b: [2]int{0, 100000}, union: 100000, shared: 100},
+		"dense and sparse":  {a: [2]int{0, 100000}, b: [2]int{99990, 100010}, union: 100010, shared: 10},
+		"dense and dense":   {a: [2]int{0, 100000}, b: [2]int{50000, 150000}, union: 150000, shared: 50000},
+		"sparse to dense":   {a: [2]int{0, 3000}, b: [2]int{3000, 6000}, union: 6000, shared: 0},
+		"disjoint":          {a: [2]int{0, 10}, b: [2]int{10, 20}, union: 20, shared: 0},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			a := probabilistic.SketchOf(rangeIterator(test.a[0], test.a[1]), 14, hasher)
+			b := probabilistic.SketchOf(rangeIterator(test.b[0], test.b[1]), 14, hasher)
+			sizeA := a.Estimate()
+
+			union, err := a.Union(b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertEstimate(t, test.union, union.Estimate(), 0.03)
+			assertEstimate(t, test.a[1]-test.a[0], a.Estimate(), 0.03)
+
+			if a.Estimate() != sizeA {
+				t.Errorf("expected Union not to modify its operands")
+			}
+
+			shared, err := probabilistic.EstimateIntersection(a, b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// the absolute error depends on the size of the union
+			assertWithin(t, test.shared, shared, 0.03*float64(test.union)+1)
+
+			err = a.Merge(b)
+			if err != nil || a.Estimate() != union.Estimate() {
+				t.Errorf("expected Merge to be equivalent to Union but got %v", err)
+			}
+		})
+	}
+}
+
+func TestEstimateIntersection_bounds(t *testing.T) {
+	var hasher probabilistic.Hasher[int] = multiplicative{}
+
+	// the estimate of the union of these sketches is smaller than the estimate of each sketch
+	large := probabilistic.SketchOf(rangeIterator(0, 17), 4, hasher)
+	small := probabilistic.SketchOf(rangeIterator(0, 15), 4, hasher)
+
+	shared, err := probabilistic.EstimateIntersection(large, small)
+	if err != nil || shared > large.Estimate() || shared > small.Estimate() {
+		t.Errorf("expected the estimate to be bounded by both sketches but got %d (%v)", shared, err)
+	}
+
+	shared, err = probabilistic.EstimateIntersection(small, large)
+	if err != nil || shared > large.Estimate() || shared > small.Estimate() {
+		t.Errorf("expected the estimate to be bounded by both sketches but got %d (%v)", shared, err)
+	}
+
+	for i := 0; i < 100; i++ {
+		a := probabilistic.SketchOf(rangeIterator(i*1000, i*1000+1000), 4, hasher)
+		b := probabilistic.SketchOf(rangeIterator(-i*1000-1000, -i*1000), 4, hasher)
+
+		shared, err = probabilistic.EstimateIntersection(a, b)
+		if err != nil || shared > a.Estimate() || shared > b.Estimate() {
+			t.Errorf("unexpected estimate %d (%v)", shared, err)
+		}
+	}
+}
+
+func TestSketch_incompatible(t *testing.T) {
+	hasher := probabilistic.NewMapHasher[int]()
+	sketch := probabilistic.NewSketch[int](10, hasher)
+
+	for name, other := range map[string]*probabilistic.Sketch[int]{
+		"precision": probabilistic.NewSketch[int](11, hasher),
+		"hasher":    probabilistic.NewSketch[int](10, nil),
+	} {
+		err := sketch.Merge(other)
+		if !errors.Is(err, probabilistic.ErrIncompatible) {
+			t.Errorf("%s: expected ErrIncompatible but got %v", name, err)
+		}
+
+		_, err = probabilistic.EstimateIntersection(sketch, other)
+		if !errors.Is(err, probabilistic.ErrIncompatible) {
+			t.Errorf("%s: expected ErrIncompatible but got %v", name, err)
+		}
+	}
+}
+
+func TestSketch_MarshalBinary(t *testing.T) {
+	var hasher probabilistic.Hasher[int] = multiplicative{}
+
+	for _, cardinality := range []int{0, 10, 1000, 100000} {
+		cardinality := cardinality
+
+		t.Run(fmt.Sprint(cardinality), func(t *testing.T) {
+			sketch := probabilistic.SketchOf(rangeIterator(0, cardinality), 12, hasher)
+
+			data, err := sketch.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(data) > 11+4096*6/8 {
+				t.Errorf("expected a compact encoding but got %d bytes", len(data))
+			}
+
+			decoded := probabilistic.NewSketch[int](4, hasher)
+			decoded.Add(-1)
+
+			err = decoded.UnmarshalBinary(data)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if decoded.Estimate() != sketch.Estimate() {
+				t.Errorf("expected %d but got %d", sketch.Estimate(), decoded.Estimate())
+			}
+
+			decoded.Add(cardinality)
+			sketch.Add(cardinality)
+
+			if decoded.Estimate() != sketch.Estimate() {
+				t.Errorf("expected the decoded sketch to behave like the original")
+			}
+
+			err = sketch.Merge(decoded)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// sketchData returns an encoded sketch of multiplicative, which hashes the zero value to 0.
+func sketchData(precision, mode byte, body ...byte) []byte {
+	return append([]byte{1, precision, mode, 0, 0, 0, 0, 0, 0, 0, 0}, body...)
+}
+
+func TestSketch_UnmarshalBinary_invalid(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":               {},
+		"short header":        {1, 10, 0, 0},
+		"version":             {2, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		"low precision":       sketchData(3, 0, 0),
+		"high precision":      sketchData(19, 0, 0),
+		"mode":                sketchData(10, 2, 0),
+		"registers":           sketchData(4, 1, 0),
+		"missing count":       sketchData(10, 0),
+		"large count":         sketchData(10, 0, 0x80, 0x80, 0x80, 0x80, 0x01),
+		"count exceeds data":  sketchData(10, 0, 0x80, 0x80, 0x80, 0x01, 1),
+		"missing entry":       sketchData(10, 0, 1),
+		"duplicate entry":     sketchData(10, 0, 2, 1, 0),
+		"zero rank":           sketchData(10, 0, 1, 64),
+		"large rank":          sketchData(10, 0, 1, 63),
+		"large index":         sketchData(10, 0, 1, 0x81, 0x80, 0x80, 0x80, 0x80, 0x01),
+		"trailing bytes":      sketchData(10, 0, 1, 1, 0),
+		"truncated registers": sketchData(4, 1, make([]byte, 11)...),
+	}
+
+	for name, data := range tests {
+		sketch := probabilistic.NewSketch[int](10, multiplicative{})
+
+		err := sketch.UnmarshalBinary(data)
+		if !errors.Is(err, cantor.ErrInvalidEncoding) {
+			t.Errorf("%s: expected ErrInvalidEncoding but got %v", name, err)
+		}
+	}
+}
+
+func TestSketch_UnmarshalBinary_hasher(t *testing.T) {
+	data, err := probabilistic.SketchOf(rangeIterator(0, 100), 10, nil).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// sketches without a hasher share the default hasher within a process
+	decoded := probabilistic.NewSketch[int](10, nil)
+	if err = decoded.UnmarshalBinary(data); err != nil || decoded.Estimate() != 100 {
+		t.Errorf("expected the sketch to be decoded but got %d (%v)", decoded.Estimate(), err)
+	}
+
+	if err = decoded.Merge(probabilistic.NewSketch[int](10, nil)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	for name, hasher := range map[string]probabilistic.Hasher[int]{
+		"map hasher": probabilistic.NewMapHasher[int](),
+		"function":   probabilistic.HasherFunc[int](multiplicative{}.Hash),
+	} {
+		err = probabilistic.NewSketch[int](10, hasher).UnmarshalBinary(data)
+		if !errors.Is(err, probabilistic.ErrIncompatible) {
+			t.Errorf("%s: expected ErrIncompatible but got %v", name, err)
+		}
+	}
+}
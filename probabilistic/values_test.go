@@ -0,0 +1,41 @@
+package probabilistic
+
+import (
+	"hash/maphash"
+	"reflect"
+	"testing"
+)
+
+// boxed is only comparable since go1.20, so it is hashed without the comparable constraint of mapHasher.
+type boxed struct {
+	A any
+}
+
+func hashValue(seed maphash.Seed, value any) uint64 {
+	var hash maphash.Hash
+
+	hash.SetSeed(seed)
+	writeValue(&hash, reflect.ValueOf(value))
+
+	return hash.Sum64()
+}
+
+func TestWriteValue_interfaces(t *testing.T) {
+	seed := maphash.MakeSeed()
+
+	if hashValue(seed, boxed{1}) != hashValue(seed, boxed{1}) ||
+		hashValue(seed, boxed{"a"}) != hashValue(seed, boxed{"a"}) {
+		t.Errorf("expected equal values to have equal hashes")
+	}
+
+	seen := map[uint64]boxed{}
+
+	for _, value := range []boxed{{}, {1}, {2}, {"a"}, {boxed{}}} {
+		hash := hashValue(seed, value)
+		if previous, ok := seen[hash]; ok {
+			t.Errorf("expected different hashes for %v and %v", previous, value)
+		}
+
+		seen[hash] = value
+	}
+}
@@ -0,0 +1,120 @@
+package query
+
+import "fmt"
+
+// [Expr] is a node of a parsed set expression.
+// It is implemented by [*Ident], [*Complement] and [*Binary].
+type Expr interface {
+	fmt.Stringer
+
+	// Pos returns the byte offset of this expression in the parsed input.
+	Pos() int
+
+	precedence() int
+}
+
+// [Operator] is a binary operator of a set expression.
+type Operator byte
+
+// Binary operators ordered from lowest to highest precedence.
+const (
+	Union               Operator = '|'
+	SymmetricDifference Operator = '^'
+	Difference          Operator = '-'
+	Intersection        Operator = '&'
+)
+
+const complementPrecedence = 5
+
+func (operator Operator) precedence() int {
+	switch operator {
+	case Union:
+		return 1
+	case SymmetricDifference:
+		return 2
+	case Difference:
+		return 3
+	case Intersection:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// [Ident] references a named set of the [Env], in which the expression is evaluated.
+type Ident struct {
+	Name   string
+	Offset int
+}
+
+// Pos returns the byte offset of this identifier in the parsed input.
+func (ident *Ident) Pos() int {
+	return ident.Offset
+}
+
+// String returns the name of the identifier.
+func (ident *Ident) String() string {
+	return ident.Name
+}
+
+func (ident *Ident) precedence() int {
+	return complementPrecedence + 1
+}
+
+// [Complement] represents all elements, which are not contained in the operand.
+// It is written as ~operand.
+type Complement struct {
+	Operand Expr
+	Offset  int
+}
+
+// Pos returns the byte offset of the complement operator in the parsed input.
+func (complement *Complement) Pos() int {
+	return complement.Offset
+}
+
+// String formats the complement with as few parentheses as possible.
+func (complement *Complement) String() string {
+	return "~" + parenthesize(complement.Operand, complement.Operand.precedence() < complementPrecedence)
+}
+
+func (complement *Complement) precedence() int {
+	return complementPrecedence
+}
+
+// [Binary] combines two operands using an [Operator].
+type Binary struct {
+	Operator Operator
+	Left     Expr
+	Right    Expr
+	Offset   int
+}
+
+// Pos returns the byte offset of the operator in the parsed input.
+func (binary *Binary) Pos() int {
+	return binary.Offset
+}
+
+// String formats the expression with as few parentheses as possible.
+// All operators are left-associative, so parsing the result yields an equal expression.
+func (binary *Binary) String() string {
+	precedence := binary.precedence()
+
+	return fmt.Sprintf("%s %c %s",
+		parenthesize(binary.Left, binary.Left.precedence() < precedence),
+		binary.Operator,
+		parenthesize(binary.Right, binary.Right.precedence() <= precedence),
+	)
+}
+
+func (binary *Binary) precedence() int {
+	return binary.Operator.precedence()
+}
+
+func parenthesize(expr Expr, parentheses bool) string {
+	if parentheses {
+		return "(" + expr.String() + ")"
+	}
+
+	return expr.String()
+}
@@ -0,0 +1,46 @@
+package query_test
+
+import (
+	"fmt"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/query"
+)
+
+// Audiences can be defined as expressions over named sets, for example in a configuration file.
+// The evaluated expression is a data view and reflects changes of the underlying sets.
+func ExampleEval() {
+	banned := cantor.NewHashSet("mallory")
+	env := query.Env[string]{
+		"admins":  cantor.NewHashSet("alice"),
+		"editors": cantor.NewHashSet("bob", "mallory"),
+		"active":  cantor.NewImplicitSet(func(name string) bool { return name != "bob" }),
+		"banned":  banned,
+	}
+
+	audience, err := query.Eval("(admins | editors) & active - banned", env)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(audience)
+
+	banned.Remove("mallory")
+
+	fmt.Println(audience.(cantor.ReadableSet[string]).Size())
+	// Output:
+	// {alice}
+	// 2
+}
+
+// Parsed expressions can be formatted with as few parentheses as necessary.
+func ExampleParse() {
+	expr, err := query.Parse("((admins) | (editors & ~(banned)))")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(expr)
+	// Output:
+	// admins | editors & ~banned
+}
@@ -0,0 +1,206 @@
+package query
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// [Error] describes a failure to parse or evaluate a set expression.
+type Error struct {
+	// Offset is the byte offset in the input, at which the error occurred.
+	Offset int
+	// Message describes the error.
+	Message string
+}
+
+// Error implements the error interface.
+func (err *Error) Error() string {
+	return fmt.Sprintf("query: error at offset %d: %s", err.Offset, err.Message)
+}
+
+// [Parse] parses a set expression into an [Expr].
+//
+// Identifiers consist of letters, digits, underscores and dots and must not start with a digit.
+// The operators are, from highest to lowest precedence:
+//
+//	~a     complement
+//	a & b  intersection
+//	a - b  difference
+//	a ^ b  symmetric difference
+//	a | b  union
+//
+// Binary operators are left-associative. Parentheses can be used for grouping.
+func Parse(input string) (Expr, error) {
+	parser := parser{input: input}
+	parser.next()
+
+	expr, err := parser.parseBinary(1)
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.token != tokenEOF {
+		return nil, parser.fail("expected operator")
+	}
+
+	return expr, nil
+}
+
+type token byte
+
+const (
+	tokenEOF        token = 0
+	tokenIdent      token = 'a'
+	tokenInvalid    token = '?'
+	tokenComplement token = '~'
+	tokenOpen       token = '('
+	tokenClose      token = ')'
+)
+
+type parser struct {
+	input  string
+	offset int
+	token  token
+	start  int
+	text   string
+}
+
+// next advances to the next token.
+func (parser *parser) next() {
+	for parser.offset < len(parser.input) {
+		char, size := utf8.DecodeRuneInString(parser.input[parser.offset:])
+		if !unicode.IsSpace(char) {
+			break
+		}
+
+		parser.offset += size
+	}
+
+	parser.start = parser.offset
+
+	if parser.offset >= len(parser.input) {
+		parser.token, parser.text = tokenEOF, ""
+
+		return
+	}
+
+	char, size := utf8.DecodeRuneInString(parser.input[parser.offset:])
+
+	switch {
+	case isIdentStart(char):
+		parser.ident()
+	case isOperator(char):
+		parser.token, parser.text = token(char), string(char)
+		parser.offset += size
+	default:
+		parser.token, parser.text = tokenInvalid, string(char)
+		parser.offset += size
+	}
+}
+
+func (parser *parser) ident() {
+	for parser.offset < len(parser.input) {
+		char, size := utf8.DecodeRuneInString(parser.input[parser.offset:])
+		if !isIdentStart(char) && !unicode.IsDigit(char) && char != '.' {
+			break
+		}
+
+		parser.offset += size
+	}
+
+	parser.token, parser.text = tokenIdent, parser.input[parser.start:parser.offset]
+}
+
+// parseBinary parses a sequence of binary operations with at least the given precedence.
+func (parser *parser) parseBinary(precedence int) (Expr, error) {
+	left, err := parser.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		operator := Operator(parser.token)
+		if operator.precedence() < precedence {
+			return left, nil
+		}
+
+		offset := parser.start
+		parser.next()
+
+		right, err := parser.parseBinary(operator.precedence() + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		left = &Binary{Operator: operator, Left: left, Right: right, Offset: offset}
+	}
+}
+
+func (parser *parser) parseUnary() (Expr, error) {
+	start := parser.start
+
+	switch parser.token {
+	case tokenIdent:
+		ident := &Ident{Name: parser.text, Offset: start}
+		parser.next()
+
+		return ident, nil
+	case tokenComplement:
+		parser.next()
+
+		operand, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &Complement{Operand: operand, Offset: start}, nil
+	case tokenOpen:
+		return parser.parseParentheses()
+	default:
+		return nil, parser.fail("expected identifier, '~' or '('")
+	}
+}
+
+func (parser *parser) parseParentheses() (Expr, error) {
+	start := parser.start
+	parser.next()
+
+	expr, err := parser.parseBinary(1)
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.token != tokenClose {
+		if parser.token == tokenEOF {
+			return nil, &Error{Offset: start, Message: "unclosed '('"}
+		}
+
+		return nil, parser.fail("expected operator or ')'")
+	}
+
+	parser.next()
+
+	return expr, nil
+}
+
+func (parser *parser) fail(message string) error {
+	if parser.token == tokenEOF {
+		return &Error{Offset: parser.start, Message: message + ", got end of input"}
+	}
+
+	return &Error{Offset: parser.start, Message: fmt.Sprintf("%s, got %q", message, parser.text)}
+}
+
+func isIdentStart(char rune) bool {
+	return char == '_' || unicode.IsLetter(char)
+}
+
+func isOperator(char rune) bool {
+	switch char {
+	case '|', '^', '-', '&', '~', '(', ')':
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,110 @@
+package query_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor/query"
+)
+
+func TestParse(t *testing.T) {
+	valid := map[string]string{
+		"a":                                    "a",
+		"  a  ":                                "a",
+		"(a)":                                  "a",
+		"a | b":                                "a | b",
+		"a|b|c":                                "a | b | c",
+		"a | (b | c)":                          "a | (b | c)",
+		"a & b | c":                            "a & b | c",
+		"a & (b | c)":                          "a & (b | c)",
+		"a - b - c":                            "a - b - c",
+		"a - (b - c)":                          "a - (b - c)",
+		"a | b - c":                            "a | b - c",
+		"(a | b) - c":                          "(a | b) - c",
+		"a ^ b & c":                            "a ^ b & c",
+		"a - b ^ c":                            "a - b ^ c",
+		"~a":                                   "~a",
+		"~~a":                                  "~~a",
+		"~(a | b)":                             "~(a | b)",
+		"~a & b":                               "~a & b",
+		"(admins | editors) & active - banned": "(admins | editors) & active - banned",
+		"team.eu & user_2":                     "team.eu & user_2",
+		"größe":                                "größe",
+	}
+
+	for input, expected := range valid {
+		input, expected := input, expected
+
+		t.Run(input, func(t *testing.T) {
+			expr, err := query.Parse(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if expr.String() != expected {
+				t.Errorf("expected %s but got %s", expected, expr)
+			}
+
+			reparsed, err := query.Parse(expr.String())
+			if err != nil || reparsed.String() != expr.String() {
+				t.Errorf("formatted expression %s did not round trip: %v", expr, err)
+			}
+		})
+	}
+
+	invalid := map[string]struct {
+		offset  int
+		message string
+	}{
+		"":          {0, "expected identifier, '~' or '(', got end of input"},
+		"a |":       {3, "expected identifier, '~' or '(', got end of input"},
+		"a b":       {2, `expected operator, got "b"`},
+		"a | 1":     {4, `expected identifier, '~' or '(', got "1"`},
+		"(a | b":    {0, "unclosed '('"},
+		"(a b)":     {3, `expected operator or ')', got "b"`},
+		"a)":        {1, `expected operator, got ")"`},
+		"~":         {1, "expected identifier, '~' or '(', got end of input"},
+		"a + b":     {2, `expected operator, got "+"`},
+		"a & ~(b |": {9, "expected identifier, '~' or '(', got end of input"},
+	}
+
+	for input, expected := range invalid {
+		input, expected := input, expected
+
+		t.Run(input, func(t *testing.T) {
+			_, err := query.Parse(input)
+
+			var queryError *query.Error
+			if !errors.As(err, &queryError) {
+				t.Fatalf("expected a query.Error but got %v", err)
+			}
+
+			if queryError.Offset != expected.offset || queryError.Message != expected.message {
+				t.Errorf("expected error %q at offset %d but got %v", expected.message, expected.offset, err)
+			}
+		})
+	}
+}
+
+func TestExpr_Pos(t *testing.T) {
+	expr, err := query.Parse("a | ~b")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	binary := expr.(*query.Binary)
+	complement := binary.Right.(*query.Complement)
+
+	if binary.Pos() != 2 || binary.Left.Pos() != 0 || complement.Pos() != 4 || complement.Operand.Pos() != 5 {
+		t.Errorf("unexpected positions %d, %d, %d, %d",
+			binary.Pos(), binary.Left.Pos(), complement.Pos(), complement.Operand.Pos())
+	}
+}
+
+func TestError_Error(t *testing.T) {
+	_, err := query.Parse("a b")
+
+	if err == nil || err.Error() != `query: error at offset 2: expected operator, got "b"` {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+// Package query implements a small language for set expressions like "(admins | editors) & active - banned".
+// Expressions are parsed into an [Expr] and evaluated into data views
+// of [pkg/github.com/frederik-jatzkowski/cantor] over the named sets of an [Env].
+package query
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+// [ErrNotEnumerable] is returned by [Env.EvaluateReadable],
+// if the result of an expression can not be represented as [cantor.ReadableSet].
+var ErrNotEnumerable = errors.New("query: expression is not enumerable")
+
+// [Env] maps names to the sets, which can be referenced in an expression.
+// Values implementing [cantor.ReadableSet] can be enumerated, while all other values are treated as implicit sets.
+type Env[T comparable] map[string]cantor.Container[T]
+
+// [Eval] parses the expression and evaluates it in env.
+// See [Parse] for the syntax and [Env.Evaluate] for the semantics.
+func Eval[T comparable](expr string, env Env[T]) (cantor.Container[T], error) {
+	parsed, err := Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return env.Evaluate(parsed)
+}
+
+// Evaluate returns a data view representing the result of expr.
+// If the result is enumerable, it implements [cantor.ReadableSet], otherwise it is a [cantor.ImplicitSet].
+//
+// The result is enumerable, if all sets, which drive the enumeration, are enumerable:
+// both operands of a union or symmetric difference, one operand of an intersection and
+// the left operand of a difference. A complement is never enumerable.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (env Env[T]) Evaluate(expr Expr) (cantor.Container[T], error) {
+	switch expr := expr.(type) {
+	case *Ident:
+		container, ok := env[expr.Name]
+		if !ok {
+			return nil, &Error{Offset: expr.Offset, Message: fmt.Sprintf("undefined set %q", expr.Name)}
+		}
+
+		return container, nil
+	case *Complement:
+		operand, err := env.Evaluate(expr.Operand)
+		if err != nil {
+			return nil, err
+		}
+
+		return implicit(operand).Complement(), nil
+	case *Binary:
+		return env.evaluateBinary(expr)
+	default:
+		return nil, fmt.Errorf("query: unsupported expression %T", expr)
+	}
+}
+
+// EvaluateReadable works like [Env.Evaluate], but returns [ErrNotEnumerable] if the result is not enumerable.
+func (env Env[T]) EvaluateReadable(expr Expr) (cantor.ReadableSet[T], error) {
+	result, err := env.Evaluate(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	readable, ok := result.(cantor.ReadableSet[T])
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotEnumerable, expr)
+	}
+
+	return readable, nil
+}
+
+func (env Env[T]) evaluateBinary(expr *Binary) (cantor.Container[T], error) {
+	left, err := env.Evaluate(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := env.Evaluate(expr.Right)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := left.(cantor.ReadableSet[T]); !ok && expr.Operator == Intersection {
+		// intersections are commutative, so an enumerable right operand can drive the iteration
+		left, right = right, left
+	}
+
+	if result, ok := evaluateReadable(expr.Operator, left, right); ok {
+		return result, nil
+	}
+
+	return evaluateImplicit(expr.Operator, implicit(left), right), nil
+}
+
+// evaluateReadable applies the operator to enumerable operands. It returns false, if the result is not enumerable.
+func evaluateReadable[T comparable](
+	operator Operator,
+	left cantor.Container[T],
+	right cantor.Container[T],
+) (cantor.Container[T], bool) {
+	leftReadable, ok := left.(cantor.ReadableSet[T])
+	if !ok {
+		return nil, false
+	}
+
+	switch operator {
+	case Intersection:
+		return leftReadable.Intersect(right), true
+	case Difference:
+		return leftReadable.Difference(right), true
+	}
+
+	rightReadable, ok := right.(cantor.ReadableSet[T])
+	if !ok {
+		return nil, false
+	}
+
+	if operator == Union {
+		return leftReadable.Union(rightReadable), true
+	}
+
+	return leftReadable.SymmetricDifference(rightReadable), true
+}
+
+func evaluateImplicit[T comparable](
+	operator Operator,
+	left cantor.ImplicitSet[T],
+	right cantor.Container[T],
+) cantor.ImplicitSet[T] {
+	switch operator {
+	case Union:
+		return left.Union(right)
+	case SymmetricDifference:
+		return left.SymmetricDifference(right)
+	case Intersection:
+		return left.Intersect(right)
+	default:
+		return left.Difference(right)
+	}
+}
+
+func implicit[T comparable](container cantor.Container[T]) cantor.ImplicitSet[T] {
+	if set, ok := container.(cantor.ImplicitSet[T]); ok {
+		return set
+	}
+
+	// intersecting all elements with the operand keeps its safety for concurrent reads
+	return cantor.NewImplicitSet(func(element T) bool { return true }).Intersect(container)
+}
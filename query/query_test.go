@@ -0,0 +1,249 @@
+package query_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/testutils"
+	"github.com/frederik-jatzkowski/cantor/query"
+)
+
+func newEnv() query.Env[byte] {
+	return query.Env[byte]{
+		"a":    cantor.NewHashSet[byte](1, 2, 3, 4),
+		"b":    cantor.NewHashSet[byte](3, 4, 5, 6),
+		"c":    cantor.NewHashSet[byte](4, 6, 8),
+		"even": cantor.NewImplicitSet(func(element byte) bool { return element%2 == 0 }),
+		"odd":  cantor.NewImplicitSet(func(element byte) bool { return element%2 == 1 }),
+	}
+}
+
+func assertContainsExactly(t *testing.T, container cantor.Container[byte], expected ...byte) {
+	t.Helper()
+
+	for _, i := range testutils.AllBytes() {
+		if container.Contains(i) != testutils.SliceContains(i, expected) {
+			t.Errorf("expected Contains(%d) to be %t", i, !container.Contains(i))
+		}
+	}
+}
+
+func TestEval(t *testing.T) {
+	tests := map[string]struct {
+		expected   []byte
+		enumerable bool
+	}{
+		"a":               {[]byte{1, 2, 3, 4}, true},
+		"a | b":           {[]byte{1, 2, 3, 4, 5, 6}, true},
+		"a & b":           {[]byte{3, 4}, true},
+		"a - b":           {[]byte{1, 2}, true},
+		"a ^ b":           {[]byte{1, 2, 5, 6}, true},
+		"a & even":        {[]byte{2, 4}, true},
+		"even & a":        {[]byte{2, 4}, true},
+		"a - even":        {[]byte{1, 3}, true},
+		"a & ~b":          {[]byte{1, 2}, true},
+		"~b & a":          {[]byte{1, 2}, true},
+		"(a | b) & c - b": {[]byte{}, true},
+		"(a | b) - c & b": {[]byte{1, 2, 3, 5}, true},
+		"a | b ^ c":       {[]byte{1, 2, 3, 4, 5, 8}, true},
+		"even - a & b":    {evenBytesExcept(4), false},
+		"a | even & c":    {[]byte{1, 2, 3, 4, 6, 8}, true},
+		"a ^ odd":         {append(oddBytesExcept(1, 3), 2, 4), false},
+	}
+
+	for input, test := range tests {
+		input, test := input, test
+
+		t.Run(input, func(t *testing.T) {
+			result, err := query.Eval(input, newEnv())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, enumerable := result.(cantor.ReadableSet[byte])
+			if enumerable != test.enumerable {
+				t.Errorf("expected enumerable to be %t", test.enumerable)
+			}
+
+			if !test.enumerable {
+				assertContainsExactly(t, result, test.expected...)
+
+				return
+			}
+
+			if !result.(cantor.ReadableSet[byte]).Equals(cantor.NewHashSet(test.expected...)) {
+				t.Errorf("expected %v but got %v", test.expected, result)
+			}
+		})
+	}
+
+	t.Run("implicit operations", func(t *testing.T) {
+		env := newEnv()
+
+		tests := map[string][]byte{
+			"~a & even & c":      {6, 8},
+			"(odd | c) & a":      {1, 3, 4},
+			"(even ^ odd) & a":   {1, 2, 3, 4},
+			"(even - c) & a":     {2},
+			"(even & odd) & a":   {},
+			"(~(odd - b)) & a":   {2, 3, 4},
+			"(odd | even) - a":   {},
+			"a & ~~(odd ^ even)": {1, 2, 3, 4},
+		}
+
+		for input, expected := range tests {
+			result, err := query.Eval(input, env)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			readable, ok := result.(cantor.ReadableSet[byte])
+			if input == "(odd | even) - a" {
+				assertContainsExactly(t, result, complementOf(1, 2, 3, 4)...)
+
+				continue
+			}
+
+			if !ok || !readable.Equals(cantor.NewHashSet(expected...)) {
+				t.Errorf("%s: expected %v but got %v", input, expected, result)
+			}
+		}
+	})
+
+	t.Run("data view", func(t *testing.T) {
+		env := newEnv()
+
+		result, err := query.Eval("a - b", env)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		env["b"].(cantor.HashSet[byte]).Add(1)
+
+		assertContainsExactly(t, result, 2)
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		_, err := query.Eval("a |", newEnv())
+
+		var queryError *query.Error
+		if !errors.As(err, &queryError) || queryError.Offset != 3 {
+			t.Errorf("expected a query.Error at offset 3 but got %v", err)
+		}
+	})
+
+	for _, input := range []string{"x", "~x", "x | a", "a | x"} {
+		input := input
+
+		t.Run("undefined "+input, func(t *testing.T) {
+			_, err := query.Eval(input, newEnv())
+
+			var queryError *query.Error
+			if !errors.As(err, &queryError) || queryError.Message != `undefined set "x"` {
+				t.Errorf("expected undefined set error but got %v", err)
+			}
+		})
+	}
+}
+
+func TestEnv_EvaluateReadable(t *testing.T) {
+	t.Run("enumerable", func(t *testing.T) {
+		expr, _ := query.Parse("a & ~b")
+
+		result, err := newEnv().EvaluateReadable(expr)
+		if err != nil || !result.Equals(cantor.NewHashSet[byte](1, 2)) {
+			t.Errorf("expected {1, 2} but got %v (%v)", result, err)
+		}
+	})
+
+	t.Run("not enumerable", func(t *testing.T) {
+		expr, _ := query.Parse("~a")
+
+		_, err := newEnv().EvaluateReadable(expr)
+		if !errors.Is(err, query.ErrNotEnumerable) || err.Error() != "query: expression is not enumerable: ~a" {
+			t.Errorf("expected ErrNotEnumerable but got %v", err)
+		}
+	})
+
+	t.Run("undefined", func(t *testing.T) {
+		expr, _ := query.Parse("x")
+
+		_, err := newEnv().EvaluateReadable(expr)
+
+		var queryError *query.Error
+		if !errors.As(err, &queryError) {
+			t.Errorf("expected a query.Error but got %v", err)
+		}
+	})
+}
+
+// unsafeContainer does not implement cantor.ConcurrentReader.
+type unsafeContainer struct{}
+
+func (unsafeContainer) Contains(element byte) bool {
+	return element%2 == 0
+}
+
+func TestEval_concurrencySafety(t *testing.T) {
+	env := newEnv()
+	env["unsafe"] = unsafeContainer{}
+
+	for _, expr := range []string{"a & ~unsafe", "a & (even | unsafe)", "a & (unsafe - even)", "a - (unsafe ^ even)"} {
+		container, err := query.Eval(expr, env)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err = cantor.EvaluateParallel(container.(cantor.ReadableSet[byte]), 2)
+		if !errors.Is(err, cantor.ErrNotConcurrencySafe) {
+			t.Errorf("expected ErrNotConcurrencySafe for %q but got %v", expr, err)
+		}
+	}
+
+	container, err := query.Eval("a & ~b", env)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err = cantor.EvaluateParallel(container.(cantor.ReadableSet[byte]), 2); err != nil {
+		t.Errorf("unexpected error for safe operands: %v", err)
+	}
+}
+
+func TestEnv_Evaluate(t *testing.T) {
+	_, err := newEnv().Evaluate(nil)
+	if err == nil {
+		t.Error("expected an error for a nil expression")
+	}
+}
+
+func evenBytesExcept(excluded ...byte) (result []byte) {
+	for _, i := range testutils.AllBytes() {
+		if i%2 == 0 && !testutils.SliceContains(i, excluded) {
+			result = append(result, i)
+		}
+	}
+
+	return result
+}
+
+func oddBytesExcept(excluded ...byte) (result []byte) {
+	for _, i := range testutils.AllBytes() {
+		if i%2 == 1 && !testutils.SliceContains(i, excluded) {
+			result = append(result, i)
+		}
+	}
+
+	return result
+}
+
+func complementOf(excluded ...byte) (result []byte) {
+	for _, i := range testutils.AllBytes() {
+		if !testutils.SliceContains(i, excluded) {
+			result = append(result, i)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,31 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// [Error] describes a problem of a rule.
+type Error struct {
+	// Rule is the name of the rule.
+	Rule string
+	// Offset is the byte offset in the expression of the rule, at which the problem occurred.
+	Offset  int
+	Message string
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("rules: rule %q at offset %d: %s", err.Rule, err.Offset, err.Message)
+}
+
+// [Errors] is returned by [RuleSet.Validate] and contains all problems of a [RuleSet].
+type Errors []*Error
+
+func (errs Errors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
@@ -0,0 +1,70 @@
+package rules_test
+
+import (
+	"fmt"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/testutils"
+	"github.com/frederik-jatzkowski/cantor/query"
+	"github.com/frederik-jatzkowski/cantor/rules"
+)
+
+// Rules can be used to control access during an election and to explain why a person may not vote.
+func Example_election() {
+	jeff := testutils.Person{Id: 1, Name: "Jeff", Age: 21}
+	bob := testutils.Person{Id: 3, Name: "Bob", Age: 17}
+	hasVoted := cantor.NewHashSet[testutils.Person]()
+
+	election := rules.New(query.Env[testutils.Person]{
+		"citizens": cantor.NewHashSet(jeff, bob),
+		"adults":   cantor.NewImplicitSet(testutils.Person.IsOffAge),
+		"hasVoted": hasVoted,
+	})
+
+	for _, definition := range [][2]string{
+		{"canVote", "eligible - hasVoted"},
+		{"eligible", "citizens & adults"},
+	} {
+		err := election.Define(definition[0], definition[1])
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	err := election.Validate()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(election.Matches("canVote", jeff))
+
+	hasVoted.Add(jeff)
+
+	fmt.Println(election.Matches("canVote", jeff))
+
+	explanation, _ := election.Explain("canVote", bob)
+	fmt.Println(explanation)
+	// Output:
+	// true <nil>
+	// false <nil>
+	// canVote: failed
+	//   eligible - hasVoted: failed
+	//     eligible: failed
+	//       citizens & adults: failed
+	//         citizens: matched
+	//         adults: failed
+	//     hasVoted: failed
+}
+
+// Validation reports undefined references and cyclic definitions.
+func ExampleRuleSet_Validate() {
+	ruleSet := rules.New(query.Env[int]{"all": cantor.NewImplicitSet(func(int) bool { return true })})
+
+	_ = ruleSet.Define("a", "all - b")
+	_ = ruleSet.Define("b", "a | missing")
+
+	fmt.Println(ruleSet.Validate())
+	// Output:
+	// rules: rule "b" at offset 4: undefined reference "missing"
+	// rules: rule "a" at offset 0: cyclic definition a -> b -> a
+}
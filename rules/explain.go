@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/frederik-jatzkowski/cantor/query"
+)
+
+// [Explanation] describes, whether an element matched an expression and why.
+type Explanation struct {
+	// Expr is the explained expression.
+	Expr query.Expr
+	// Rule is the name of the referenced rule, if Expr references a rule.
+	Rule string
+	// Matched is true, if the element is contained in the result of Expr.
+	Matched bool
+	// Children contains the explanations of the operands of Expr
+	// or the explanation of the definition of the referenced rule.
+	Children []*Explanation
+}
+
+// Explain evaluates the named rule for the element and returns an [Explanation],
+// which shows the results of all referenced rules, containers and subexpressions.
+// It returns the same errors as [RuleSet.Evaluate].
+func (rules *RuleSet[T]) Explain(name string, element T) (*Explanation, error) {
+	_, err := rules.Evaluate(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules.explain(&query.Ident{Name: name}, element), nil
+}
+
+func (rules *RuleSet[T]) explain(expr query.Expr, element T) *Explanation {
+	explanation := &Explanation{Expr: expr}
+
+	switch expr := expr.(type) {
+	case *query.Ident:
+		if container, ok := rules.containers[expr.Name]; ok {
+			explanation.Matched = container.Contains(element)
+
+			break
+		}
+
+		definition := rules.explain(rules.rules[expr.Name], element)
+		explanation.Rule = expr.Name
+		explanation.Matched = definition.Matched
+		explanation.Children = []*Explanation{definition}
+	case *query.Complement:
+		operand := rules.explain(expr.Operand, element)
+		explanation.Matched = !operand.Matched
+		explanation.Children = []*Explanation{operand}
+	default:
+		binary := expr.(*query.Binary)
+		left, right := rules.explain(binary.Left, element), rules.explain(binary.Right, element)
+		explanation.Matched = combine(binary.Operator, left.Matched, right.Matched)
+		explanation.Children = []*Explanation{left, right}
+	}
+
+	return explanation
+}
+
+func combine(operator query.Operator, left, right bool) bool {
+	switch operator {
+	case query.Union:
+		return left || right
+	case query.SymmetricDifference:
+		return left != right
+	case query.Difference:
+		return left && !right
+	default:
+		return left && right
+	}
+}
+
+// String formats the explanation as an indented tree with one line per expression, for example:
+//
+//	canVote: failed
+//	  citizens & adults - hasVoted: failed
+//	    citizens & adults: matched
+//	      citizens: matched
+//	      adults: matched
+//	    hasVoted: matched
+func (explanation *Explanation) String() string {
+	var builder strings.Builder
+
+	explanation.format(&builder, 0)
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+func (explanation *Explanation) format(builder *strings.Builder, depth int) {
+	builder.WriteString(strings.Repeat("  ", depth))
+	builder.WriteString(explanation.Expr.String())
+
+	if explanation.Matched {
+		builder.WriteString(": matched\n")
+	} else {
+		builder.WriteString(": failed\n")
+	}
+
+	for _, child := range explanation.Children {
+		child.format(builder, depth+1)
+	}
+}
@@ -0,0 +1,59 @@
+package rules_test
+
+import (
+	"testing"
+)
+
+func TestRuleSet_Explain(t *testing.T) {
+	ruleSet := newRuleSet(t,
+		"smallEven", "small & even",
+		"rule", "~smallEven - odd | small ^ even",
+	)
+
+	tests := map[int]string{
+		6: `rule: matched
+  ~smallEven - odd | small ^ even: matched
+    ~smallEven - odd: matched
+      ~smallEven: matched
+        smallEven: failed
+          small & even: failed
+            small: failed
+            even: matched
+      odd: failed
+    small ^ even: matched
+      small: failed
+      even: matched`,
+		7: `rule: failed
+  ~smallEven - odd | small ^ even: failed
+    ~smallEven - odd: failed
+      ~smallEven: matched
+        smallEven: failed
+          small & even: failed
+            small: failed
+            even: failed
+      odd: matched
+    small ^ even: failed
+      small: failed
+      even: failed`,
+	}
+
+	for element, expected := range tests {
+		explanation, err := ruleSet.Explain("rule", element)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if explanation.String() != expected {
+			t.Errorf("expected\n%s\nbut got\n%s", expected, explanation)
+		}
+
+		if explanation.Rule != "rule" || len(explanation.Children) != 1 {
+			t.Errorf("unexpected structure of the explanation")
+		}
+	}
+
+	_, err := ruleSet.Explain("missing", 1)
+	if err == nil {
+		t.Errorf("expected an error for an undefined rule")
+	}
+}
@@ -0,0 +1,184 @@
+// Package rules implements named rules, which are defined as set expressions over containers.
+//
+// Rules are written in the syntax of [pkg/github.com/frederik-jatzkowski/cantor/query]
+// and can reference the containers of the [RuleSet] as well as other rules:
+//
+//	rules := rules.New(query.Env[Person]{
+//		"citizens": citizens,
+//		"adults":   adults,
+//		"hasVoted": hasVoted,
+//	})
+//
+//	err := rules.Define("canVote", "citizens & adults - hasVoted")
+//
+// Rules are evaluated into data views, which reflect future changes of the underlying containers.
+// [RuleSet.Explain] reports which parts of a rule matched or failed for a given element
+// and [RuleSet.Validate] reports undefined references and cyclic definitions.
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/query"
+)
+
+// [RuleSet] is a collection of named rules over named containers.
+//
+// Rules may be defined in any order. Defining rules must not happen concurrently with other method calls.
+type RuleSet[T comparable] struct {
+	containers query.Env[T]
+	rules      map[string]query.Expr
+	names      []string
+}
+
+// [New] returns a [RuleSet] without rules, whose rules can reference the given containers.
+func New[T comparable](containers query.Env[T]) *RuleSet[T] {
+	return &RuleSet[T]{
+		containers: containers,
+		rules:      map[string]query.Expr{},
+	}
+}
+
+// Define adds a rule with the given name and set expression.
+// It returns a [*query.Error], if the expression can not be parsed, and an [*Error],
+// if the name is invalid or already used by another rule or container.
+//
+// References are resolved lazily, so a rule may reference rules, which are defined later.
+// Use [RuleSet.Validate] to check all references once all rules are defined.
+func (rules *RuleSet[T]) Define(name, expr string) error {
+	ident, err := query.Parse(name)
+	if _, ok := ident.(*query.Ident); err != nil || !ok || ident.String() != name {
+		return &Error{Rule: name, Message: "invalid rule name"}
+	}
+
+	if _, ok := rules.containers[name]; ok {
+		return &Error{Rule: name, Message: "name is already used by a container"}
+	}
+
+	if _, ok := rules.rules[name]; ok {
+		return &Error{Rule: name, Message: "rule is already defined"}
+	}
+
+	parsed, err := query.Parse(expr)
+	if err != nil {
+		return err
+	}
+
+	rules.rules[name] = parsed
+	rules.names = append(rules.names, name)
+
+	return nil
+}
+
+// Names returns the names of all rules in the order of their definition.
+func (rules *RuleSet[T]) Names() []string {
+	return append([]string(nil), rules.names...)
+}
+
+// Expr returns the parsed expression of the named rule and false, if no such rule is defined.
+func (rules *RuleSet[T]) Expr(name string) (query.Expr, bool) {
+	expr, ok := rules.rules[name]
+
+	return expr, ok
+}
+
+// Evaluate returns a data view representing all elements matching the named rule.
+// If the result is enumerable, it implements [cantor.ReadableSet], otherwise it is a [cantor.ImplicitSet].
+// See [query.Env.Evaluate] for details.
+//
+// An [*Error] is returned, if the rule or one of its references is not defined or if the definition is cyclic.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (rules *RuleSet[T]) Evaluate(name string) (cantor.Container[T], error) {
+	return rules.evaluate(name, map[string]cantor.Container[T]{}, nil)
+}
+
+// Matches returns true, if the element matches the named rule.
+// It returns the same errors as [RuleSet.Evaluate].
+func (rules *RuleSet[T]) Matches(name string, element T) (bool, error) {
+	container, err := rules.Evaluate(name)
+	if err != nil {
+		return false, err
+	}
+
+	return container.Contains(element), nil
+}
+
+// evaluate returns the container of the named rule or container.
+// Evaluated rules are cached in evaluated. The stack contains the rules currently being evaluated.
+func (rules *RuleSet[T]) evaluate(
+	name string,
+	evaluated map[string]cantor.Container[T],
+	stack []string,
+) (cantor.Container[T], error) {
+	if container, ok := rules.containers[name]; ok {
+		return container, nil
+	}
+
+	if container, ok := evaluated[name]; ok {
+		return container, nil
+	}
+
+	expr, ok := rules.rules[name]
+	if !ok {
+		return nil, rules.undefined(name, stack)
+	}
+
+	for i, previous := range stack {
+		if previous == name {
+			return nil, &Error{Rule: name, Message: "cyclic definition " + strings.Join(append(stack[i:], name), " -> ")}
+		}
+	}
+
+	env := query.Env[T]{}
+
+	for _, reference := range references(expr) {
+		container, err := rules.evaluate(reference.Name, evaluated, append(stack, name))
+		if err != nil {
+			return nil, err
+		}
+
+		env[reference.Name] = container
+	}
+
+	// all references are resolved, so the evaluation can not fail
+	container, _ := env.Evaluate(expr)
+	evaluated[name] = container
+
+	return container, nil
+}
+
+func (rules *RuleSet[T]) undefined(name string, stack []string) error {
+	if len(stack) == 0 {
+		return &Error{Rule: name, Message: "rule is not defined"}
+	}
+
+	rule := stack[len(stack)-1]
+	offset := 0
+
+	for _, reference := range references(rules.rules[rule]) {
+		if reference.Name == name {
+			offset = reference.Offset
+
+			break
+		}
+	}
+
+	return &Error{Rule: rule, Offset: offset, Message: fmt.Sprintf("undefined reference %q", name)}
+}
+
+// references returns all identifiers of expr in the order of their occurrence.
+func references(expr query.Expr) []*query.Ident {
+	switch expr := expr.(type) {
+	case *query.Ident:
+		return []*query.Ident{expr}
+	case *query.Complement:
+		return references(expr.Operand)
+	default:
+		binary := expr.(*query.Binary)
+
+		return append(references(binary.Left), references(binary.Right)...)
+	}
+}
@@ -0,0 +1,163 @@
+package rules_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/query"
+	"github.com/frederik-jatzkowski/cantor/rules"
+)
+
+func newRuleSet(t *testing.T, definitions ...string) *rules.RuleSet[int] {
+	t.Helper()
+
+	ruleSet := rules.New(query.Env[int]{
+		"small": cantor.NewHashSet(1, 2, 3, 4, 5),
+		"even":  cantor.NewImplicitSet(func(i int) bool { return i%2 == 0 }),
+		"odd":   cantor.NewImplicitSet(func(i int) bool { return i%2 == 1 }),
+	})
+
+	for i := 0; i < len(definitions); i += 2 {
+		err := ruleSet.Define(definitions[i], definitions[i+1])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	return ruleSet
+}
+
+func TestRuleSet_Define(t *testing.T) {
+	ruleSet := newRuleSet(t, "smallEven", "small & even")
+
+	tests := map[string]struct {
+		name, expr string
+		expected   string
+	}{
+		"invalid name": {name: "a b", expr: "small", expected: `rules: rule "a b" at offset 0: invalid rule name`},
+		"operator":     {name: "~a", expr: "small", expected: `rules: rule "~a" at offset 0: invalid rule name`},
+		"empty name":   {name: "", expr: "small", expected: `rules: rule "" at offset 0: invalid rule name`},
+		"container": {
+			name:     "even",
+			expr:     "small",
+			expected: `rules: rule "even" at offset 0: name is already used by a container`,
+		},
+		"duplicate": {
+			name:     "smallEven",
+			expr:     "small",
+			expected: `rules: rule "smallEven" at offset 0: rule is already defined`,
+		},
+		"syntax error": {
+			name:     "broken",
+			expr:     "small &",
+			expected: "query: error at offset 7: expected identifier, '~' or '(', got end of input",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			err := ruleSet.Define(test.name, test.expr)
+			if err == nil || err.Error() != test.expected {
+				t.Errorf("expected %q but got %v", test.expected, err)
+			}
+		})
+	}
+
+	names := ruleSet.Names()
+	if len(names) != 1 || names[0] != "smallEven" {
+		t.Errorf("expected only smallEven to be defined but got %v", names)
+	}
+
+	expr, ok := ruleSet.Expr("smallEven")
+	if !ok || expr.String() != "small & even" {
+		t.Errorf("unexpected expression %v", expr)
+	}
+
+	_, ok = ruleSet.Expr("missing")
+	if ok {
+		t.Errorf("expected missing rule to be reported")
+	}
+}
+
+func TestRuleSet_Evaluate(t *testing.T) {
+	ruleSet := newRuleSet(t,
+		"smallOdd", "small - smallEven",
+		"smallEven", "small & even",
+		"both", "smallOdd | smallEven",
+		"large", "~small",
+	)
+
+	tests := map[string]string{
+		"smallOdd":  "{1, 3, 5}",
+		"smallEven": "{2, 4}",
+		"both":      "{1, 2, 3, 4, 5}",
+	}
+
+	for name, expected := range tests {
+		container, err := ruleSet.Evaluate(name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		readable, ok := container.(cantor.ReadableSet[int])
+		if !ok || !readable.Equals(mustParse(t, expected)) {
+			t.Errorf("expected %s to be %s but got %v", name, expected, container)
+		}
+	}
+
+	matches, err := ruleSet.Matches("large", 6)
+	if err != nil || !matches {
+		t.Errorf("expected 6 to match large but got %v (%v)", matches, err)
+	}
+
+	matches, err = ruleSet.Matches("large", 5)
+	if err != nil || matches {
+		t.Errorf("expected 5 not to match large but got %v (%v)", matches, err)
+	}
+}
+
+func TestRuleSet_Evaluate_errors(t *testing.T) {
+	ruleSet := newRuleSet(t,
+		"a", "b | small",
+		"b", "even & c",
+		"c", "~a",
+		"d", "small - undefined",
+	)
+
+	tests := map[string]string{
+		"a":       `rules: rule "a" at offset 0: cyclic definition a -> b -> c -> a`,
+		"c":       `rules: rule "c" at offset 0: cyclic definition c -> a -> b -> c`,
+		"d":       `rules: rule "d" at offset 8: undefined reference "undefined"`,
+		"missing": `rules: rule "missing" at offset 0: rule is not defined`,
+	}
+
+	for name, expected := range tests {
+		_, err := ruleSet.Matches(name, 1)
+
+		var ruleErr *rules.Error
+		if !errors.As(err, &ruleErr) || err.Error() != expected {
+			t.Errorf("expected %q but got %v", expected, err)
+		}
+	}
+}
+
+func mustParse(t *testing.T, s string) cantor.HashSet[int] {
+	t.Helper()
+
+	set, err := cantor.ParseHashSet(s, func(s string) (int, error) {
+		var i int
+
+		_, err := fmt.Sscan(s, &i)
+
+		return i, err
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return set
+}
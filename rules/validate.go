@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate checks all rules for references, which are neither rules nor containers, and for cyclic definitions.
+// It returns [Errors] containing all problems or nil, if all rules can be evaluated.
+func (rules *RuleSet[T]) Validate() error {
+	var errs Errors
+
+	for _, name := range rules.names {
+		for _, reference := range references(rules.rules[name]) {
+			_, isContainer := rules.containers[reference.Name]
+			_, isRule := rules.rules[reference.Name]
+
+			if !isContainer && !isRule {
+				errs = append(errs, &Error{
+					Rule:    name,
+					Offset:  reference.Offset,
+					Message: fmt.Sprintf("undefined reference %q", reference.Name),
+				})
+			}
+		}
+	}
+
+	state := map[string]int{}
+	for _, name := range rules.names {
+		errs = append(errs, rules.cycles(name, state, nil)...)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+const (
+	visiting = 1
+	visited  = 2
+)
+
+// cycles returns an [Error] for each cycle reachable from the named rule, which has not been visited before.
+func (rules *RuleSet[T]) cycles(name string, state map[string]int, stack []string) []*Error {
+	switch state[name] {
+	case visited:
+		return nil
+	case visiting:
+		for i, previous := range stack {
+			if previous == name {
+				return []*Error{{Rule: name, Message: "cyclic definition " + strings.Join(append(stack[i:], name), " -> ")}}
+			}
+		}
+	}
+
+	state[name] = visiting
+
+	var errs []*Error
+
+	for _, reference := range references(rules.rules[name]) {
+		if _, ok := rules.rules[reference.Name]; ok {
+			errs = append(errs, rules.cycles(reference.Name, state, append(stack, name))...)
+		}
+	}
+
+	state[name] = visited
+
+	return errs
+}
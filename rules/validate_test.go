@@ -0,0 +1,44 @@
+package rules_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor/rules"
+)
+
+func TestRuleSet_Validate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		ruleSet := newRuleSet(t, "a", "b & small", "b", "even | odd", "c", "a - b")
+
+		err := ruleSet.Validate()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		ruleSet := newRuleSet(t,
+			"a", "b & small",
+			"b", "~a | missing",
+			"c", "c",
+			"d", "a & c & unknown",
+		)
+
+		err := ruleSet.Validate()
+
+		var errs rules.Errors
+		if !errors.As(err, &errs) {
+			t.Fatalf("expected Errors but got %v", err)
+		}
+
+		expected := `rules: rule "b" at offset 5: undefined reference "missing"
+rules: rule "d" at offset 8: undefined reference "unknown"
+rules: rule "a" at offset 0: cyclic definition a -> b -> a
+rules: rule "c" at offset 0: cyclic definition c -> c`
+
+		if err.Error() != expected {
+			t.Errorf("expected\n%s\nbut got\n%s", expected, err)
+		}
+	})
+}
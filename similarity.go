@@ -0,0 +1,160 @@
+package cantor
+
+import (
+	"math"
+	"math/bits"
+)
+
+// [Bitmask] is implemented by sets, which store their elements as bits, like the sets generated by cantor-gen.
+// Similarities of two such sets are computed word by word instead of element by element.
+// All sets with the same element type must represent each element by the same bit.
+type Bitmask interface {
+	// Bits returns the words of the bitmask. The result must not be modified.
+	Bits() []uint64
+}
+
+// [Jaccard] returns the Jaccard similarity |A ∩ B| / |A ∪ B| of the sets, which is between 0 and 1.
+// Two empty sets are considered equal and have a similarity of 1.
+func Jaccard[T comparable](a, b ReadableSet[T]) float64 {
+	sizeA, sizeB, intersection := intersectionSize(a, b)
+	union := sizeA + sizeB - intersection
+
+	if union == 0 {
+		return 1
+	}
+
+	return float64(intersection) / float64(union)
+}
+
+// [Dice] returns the Sørensen–Dice coefficient 2|A ∩ B| / (|A| + |B|) of the sets, which is between 0 and 1.
+// Two empty sets are considered equal and have a similarity of 1.
+func Dice[T comparable](a, b ReadableSet[T]) float64 {
+	sizeA, sizeB, intersection := intersectionSize(a, b)
+
+	if sizeA+sizeB == 0 {
+		return 1
+	}
+
+	return 2 * float64(intersection) / float64(sizeA+sizeB)
+}
+
+// [Overlap] returns the Szymkiewicz–Simpson coefficient |A ∩ B| / min(|A|, |B|) of the sets,
+// which is 1, if one set is a subset of the other.
+func Overlap[T comparable](a, b ReadableSet[T]) float64 {
+	sizeA, sizeB, intersection := intersectionSize(a, b)
+
+	smaller := sizeA
+	if sizeB < smaller {
+		smaller = sizeB
+	}
+
+	if smaller == 0 {
+		return 1
+	}
+
+	return float64(intersection) / float64(smaller)
+}
+
+// [Cosine] returns the cosine similarity |A ∩ B| / sqrt(|A| * |B|) of the sets, which is between 0 and 1.
+// Two empty sets are considered equal and have a similarity of 1.
+func Cosine[T comparable](a, b ReadableSet[T]) float64 {
+	sizeA, sizeB, intersection := intersectionSize(a, b)
+
+	switch {
+	case sizeA == 0 && sizeB == 0:
+		return 1
+	case sizeA == 0 || sizeB == 0:
+		return 0
+	default:
+		return float64(intersection) / math.Sqrt(float64(sizeA)*float64(sizeB))
+	}
+}
+
+// [Containment] returns the fraction |A ∩ B| / |A| of the elements of a, which are contained in b.
+// The empty set is contained in every set.
+func Containment[T comparable](a, b ReadableSet[T]) float64 {
+	sizeA, _, intersection := intersectionSize(a, b)
+
+	if sizeA == 0 {
+		return 1
+	}
+
+	return float64(intersection) / float64(sizeA)
+}
+
+// [Hamming] returns the Hamming distance |A ∆ B| of the sets, which is the number of elements
+// contained in exactly one of them. It is computed word by word, if both sets implement [Bitmask].
+func Hamming[T comparable](a, b ReadableSet[T]) int {
+	maskA, okA := a.(Bitmask)
+	maskB, okB := b.(Bitmask)
+
+	if okA && okB {
+		return countBits(maskA.Bits(), maskB.Bits(), func(a, b uint64) uint64 { return a ^ b })
+	}
+
+	sizeA, sizeB, intersection := intersectionSize(a, b)
+
+	return sizeA + sizeB - 2*intersection
+}
+
+// intersectionSize returns the sizes of both sets and of their intersection.
+// Each set is enumerated at most once, so derived views are not evaluated repeatedly.
+// If both sets are hash sets, the smaller one drives the iteration.
+func intersectionSize[T comparable](a, b ReadableSet[T]) (sizeA, sizeB, intersection int) {
+	maskA, okA := a.(Bitmask)
+	maskB, okB := b.(Bitmask)
+
+	switch {
+	case okA && okB:
+		return a.Size(), b.Size(), countBits(maskA.Bits(), maskB.Bits(), func(a, b uint64) uint64 { return a & b })
+	case smallerHashSet(b, a):
+		sizeB, sizeA, intersection = countIntersection(b, a)
+
+		return sizeA, sizeB, intersection
+	default:
+		return countIntersection(a, b)
+	}
+}
+
+// countIntersection enumerates a once to count its size and its intersection with b, before b is counted.
+func countIntersection[T comparable](a, b ReadableSet[T]) (sizeA, sizeB, intersection int) {
+	a.Elements()(func(element T) (next bool) {
+		sizeA++
+
+		if b.Contains(element) {
+			intersection++
+		}
+
+		return true
+	})
+
+	return sizeA, b.Size(), intersection
+}
+
+// smallerHashSet returns true, if both sets are hash sets and a is smaller than b.
+func smallerHashSet[T comparable](a, b ReadableSet[T]) bool {
+	setA, okA := a.(HashSet[T])
+	setB, okB := b.(HashSet[T])
+
+	return okA && okB && len(setA) < len(setB)
+}
+
+// countBits counts the bits of combine applied to all pairs of words. Missing words are zero.
+func countBits(a, b []uint64, combine func(a, b uint64) uint64) int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	result := 0
+
+	for i, word := range a {
+		other := uint64(0)
+		if i < len(b) {
+			other = b[i]
+		}
+
+		result += bits.OnesCount64(combine(word, other))
+	}
+
+	return result
+}
@@ -0,0 +1,213 @@
+package cantor_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+// bitmaskSet is a set of small integers, which implements [cantor.Bitmask].
+type bitmaskSet struct {
+	cantor.HashSet[int]
+	bits []uint64
+}
+
+func newBitmaskSet(elements ...int) bitmaskSet {
+	set := bitmaskSet{HashSet: cantor.NewHashSet(elements...)}
+
+	for _, element := range elements {
+		for len(set.bits) <= element/64 {
+			set.bits = append(set.bits, 0)
+		}
+
+		set.bits[element/64] |= 1 << (element % 64)
+	}
+
+	return set
+}
+
+func (set bitmaskSet) Bits() []uint64 {
+	return set.bits
+}
+
+// enumerationCounter counts the enumerations of the embedded set.
+type enumerationCounter struct {
+	cantor.ReadableSet[int]
+	enumerations *int
+}
+
+func (set enumerationCounter) Elements() cantor.Iterator[int] {
+	*set.enumerations++
+
+	return set.ReadableSet.Elements()
+}
+
+func (set enumerationCounter) Size() int {
+	*set.enumerations++
+
+	return set.ReadableSet.Size()
+}
+
+type similarities struct {
+	jaccard, dice, overlap, cosine, containment float64
+	hamming                                     int
+}
+
+func TestSimilarities(t *testing.T) {
+	tests := map[string]struct {
+		a, b     cantor.ReadableSet[int]
+		expected similarities
+	}{
+		"empty": {
+			a:        cantor.NewHashSet[int](),
+			b:        cantor.NewHashSet[int](),
+			expected: similarities{jaccard: 1, dice: 1, overlap: 1, cosine: 1, containment: 1},
+		},
+		"empty subset": {
+			a:        cantor.NewHashSet[int](),
+			b:        cantor.NewHashSet(1, 2),
+			expected: similarities{overlap: 1, containment: 1, hamming: 2},
+		},
+		"empty superset": {
+			a:        cantor.NewHashSet(1, 2),
+			b:        cantor.NewHashSet[int](),
+			expected: similarities{overlap: 1, hamming: 2},
+		},
+		"disjoint": {
+			a:        cantor.NewHashSet(1, 2),
+			b:        cantor.NewHashSet(3),
+			expected: similarities{hamming: 3},
+		},
+		"equal": {
+			a:        cantor.NewHashSet(1, 2),
+			b:        cantor.NewHashSet(2, 1),
+			expected: similarities{jaccard: 1, dice: 1, overlap: 1, cosine: 1, containment: 1},
+		},
+		"overlap": {
+			a: cantor.NewHashSet(1, 2, 3, 4),
+			b: cantor.NewHashSet(3, 4, 5, 6, 7, 8, 9, 10, 11),
+			expected: similarities{
+				jaccard: 2.0 / 11, dice: 4.0 / 13, overlap: 0.5, cosine: 2.0 / 6, containment: 0.5, hamming: 9,
+			},
+		},
+		"larger first": {
+			a: cantor.NewHashSet(3, 4, 5, 6, 7, 8, 9, 10, 11),
+			b: cantor.NewHashSet(1, 2, 3, 4),
+			expected: similarities{
+				jaccard: 2.0 / 11, dice: 4.0 / 13, overlap: 0.5, cosine: 2.0 / 6, containment: 2.0 / 9, hamming: 9,
+			},
+		},
+		"views": {
+			a:        cantor.NewHashSet(1, 2).Union(cantor.NewHashSet(3)),
+			b:        cantor.NewHashSet(1, 2, 3, 4).Difference(cantor.NewHashSet(4)),
+			expected: similarities{jaccard: 1, dice: 1, overlap: 1, cosine: 1, containment: 1},
+		},
+		"bitmasks": {
+			a: newBitmaskSet(1, 2, 3, 4),
+			b: newBitmaskSet(3, 4, 5, 6, 7, 8, 9, 10, 100),
+			expected: similarities{
+				jaccard: 2.0 / 11, dice: 4.0 / 13, overlap: 0.5, cosine: 2.0 / 6, containment: 0.5, hamming: 9,
+			},
+		},
+		"bitmask and view": {
+			a: newBitmaskSet(1, 2, 100),
+			b: cantor.NewHashSet(2, 100),
+			expected: similarities{
+				jaccard: 2.0 / 3, dice: 0.8, overlap: 1, cosine: 2 / math.Sqrt(6), containment: 2.0 / 3, hamming: 1,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			actual := similarities{
+				jaccard:     cantor.Jaccard(test.a, test.b),
+				dice:        cantor.Dice(test.a, test.b),
+				overlap:     cantor.Overlap(test.a, test.b),
+				cosine:      cantor.Cosine(test.a, test.b),
+				containment: cantor.Containment(test.a, test.b),
+				hamming:     cantor.Hamming(test.a, test.b),
+			}
+
+			if !actual.approximately(test.expected) {
+				t.Errorf("expected %+v but got %+v", test.expected, actual)
+			}
+
+			swapped := similarities{
+				jaccard:     cantor.Jaccard(test.b, test.a),
+				dice:        cantor.Dice(test.b, test.a),
+				overlap:     cantor.Overlap(test.b, test.a),
+				cosine:      cantor.Cosine(test.b, test.a),
+				containment: actual.containment,
+				hamming:     cantor.Hamming(test.b, test.a),
+			}
+
+			if !swapped.approximately(actual) {
+				t.Errorf("expected the similarities to be symmetric but got %+v", swapped)
+			}
+		})
+	}
+}
+
+func TestSimilarities_enumerations(t *testing.T) {
+	for name, similarity := range map[string]func(a, b cantor.ReadableSet[int]) float64{
+		"Jaccard":     cantor.Jaccard[int],
+		"Dice":        cantor.Dice[int],
+		"Overlap":     cantor.Overlap[int],
+		"Cosine":      cantor.Cosine[int],
+		"Containment": cantor.Containment[int],
+		"Hamming": func(a, b cantor.ReadableSet[int]) float64 {
+			return float64(cantor.Hamming(a, b))
+		},
+	} {
+		enumerationsA, enumerationsB := 0, 0
+		a := enumerationCounter{cantor.NewHashSet(1, 2, 3).Union(cantor.NewHashSet(4)), &enumerationsA}
+		b := enumerationCounter{cantor.NewHashSet(3, 4, 5).Difference(cantor.NewHashSet(5)), &enumerationsB}
+
+		similarity(a, b)
+
+		if enumerationsA != 1 || enumerationsB != 1 {
+			t.Errorf("%s: expected each view to be enumerated once but got %d and %d", name, enumerationsA, enumerationsB)
+		}
+	}
+}
+
+func (s similarities) approximately(other similarities) bool {
+	for _, pair := range [][2]float64{
+		{s.jaccard, other.jaccard},
+		{s.dice, other.dice},
+		{s.overlap, other.overlap},
+		{s.cosine, other.cosine},
+		{s.containment, other.containment},
+	} {
+		if math.Abs(pair[0]-pair[1]) > 1e-9 {
+			return false
+		}
+	}
+
+	return s.hamming == other.hamming
+}
+
+func ExampleJaccard() {
+	alice := cantor.NewHashSet("chess", "hiking", "jazz")
+	bob := cantor.NewHashSet("hiking", "jazz", "cooking", "tennis")
+
+	fmt.Println(cantor.Jaccard[string](alice, bob))
+	// Output:
+	// 0.4
+}
+
+func ExampleContainment() {
+	required := cantor.NewHashSet("read", "write", "delete")
+	granted := cantor.NewHashSet("read", "write")
+
+	fmt.Printf("%.2f\n", cantor.Containment[string](required, granted))
+	fmt.Println(cantor.Hamming[string](required, granted))
+	// Output:
+	// 0.67
+	// 1
+}
@@ -0,0 +1,253 @@
+package symbolic
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+// [Ordered] is a constraint for types, whose values can be compared using <.
+// It is used by [Range] and the comparisons [Ge], [Gt], [Le] and [Lt].
+// Like the comparison operators of Go, these predicates never contain NaN and contain nothing, if a bound is NaN.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// atom is a type erased condition on the subject of an element.
+// The subject is the element itself or a part of it selected by [Field].
+type atom struct {
+	// path contains the names of the fields selecting the subject.
+	path []string
+	// subject selects the subject of an element.
+	subject func(element any) any
+	// condition is one of *eq, *in and *interval.
+	condition condition
+}
+
+type condition interface {
+	holds(subject any) bool
+	format(subject string) string
+}
+
+func identity(element any) any {
+	return element
+}
+
+func newAtom[T any](condition condition) Predicate[T] {
+	return Predicate[T]{node: &node{kind: kindAtom, atom: &atom{subject: identity, condition: condition}}}
+}
+
+func (a *atom) contains(element any) bool {
+	return a.condition.holds(a.subject(element))
+}
+
+// String returns the representation of this atom, which is also used to identify equivalent atoms.
+func (a *atom) String() string {
+	return a.condition.format(strings.Join(a.path, "."))
+}
+
+// eq holds for subjects equal to value.
+type eq struct {
+	value any
+}
+
+// [Eq] returns a [Predicate], which contains all elements equal to value.
+func Eq[T comparable](value T) Predicate[T] {
+	return newAtom[T](&eq{value: value})
+}
+
+func (condition *eq) holds(subject any) bool {
+	return subject == condition.value
+}
+
+func (condition *eq) format(subject string) string {
+	return subjectOrX(subject) + " = " + formatValue(condition.value)
+}
+
+// in holds for subjects contained in a named container.
+type in struct {
+	name     string
+	contains func(subject any) bool
+}
+
+// [In] returns a [Predicate], which contains all elements contained in the container.
+// The name is used to represent the container, when the predicate is formatted or analyzed.
+// Thus, different containers should have different names.
+func In[T any](name string, container cantor.Container[T]) Predicate[T] {
+	return newAtom[T](&in{
+		name: name,
+		contains: func(subject any) bool {
+			return container.Contains(subject.(T))
+		},
+	})
+}
+
+func (condition *in) holds(subject any) bool {
+	return condition.contains(subject)
+}
+
+func (condition *in) format(subject string) string {
+	if subject == "" {
+		return "in(" + condition.name + ")"
+	}
+
+	return subject + " in(" + condition.name + ")"
+}
+
+// bound is a lower or upper bound of an interval.
+type bound struct {
+	value     any
+	inclusive bool
+}
+
+// interval holds for subjects between the optional lower and upper bound.
+type interval struct {
+	lower, upper *bound
+	compare      func(a, b any) int
+}
+
+func newInterval[T Ordered](lower, upper *bound) Predicate[T] {
+	return newAtom[T](&interval{lower: lower, upper: upper, compare: compare[T]})
+}
+
+// unordered is returned by compare, if one of the values is NaN.
+const unordered = 2
+
+// compare returns -1, 0 or 1, if a is less than, equal to or greater than b, or unordered otherwise.
+func compare[T Ordered](a, b any) int {
+	switch {
+	case a.(T) < b.(T):
+		return -1
+	case a.(T) > b.(T):
+		return 1
+	case a.(T) == b.(T):
+		return 0
+	default:
+		return unordered
+	}
+}
+
+// [Range] returns a [Predicate], which contains all elements e with min <= e <= max.
+func Range[T Ordered](min, max T) Predicate[T] {
+	return newInterval[T](&bound{value: min, inclusive: true}, &bound{value: max, inclusive: true})
+}
+
+// [Ge] returns a [Predicate], which contains all elements e with e >= value.
+func Ge[T Ordered](value T) Predicate[T] {
+	return newInterval[T](&bound{value: value, inclusive: true}, nil)
+}
+
+// [Gt] returns a [Predicate], which contains all elements e with e > value.
+func Gt[T Ordered](value T) Predicate[T] {
+	return newInterval[T](&bound{value: value}, nil)
+}
+
+// [Le] returns a [Predicate], which contains all elements e with e <= value.
+func Le[T Ordered](value T) Predicate[T] {
+	return newInterval[T](nil, &bound{value: value, inclusive: true})
+}
+
+// [Lt] returns a [Predicate], which contains all elements e with e < value.
+func Lt[T Ordered](value T) Predicate[T] {
+	return newInterval[T](nil, &bound{value: value})
+}
+
+// holds returns true, if the subject is within the bounds. Since NaN is not ordered, it is never within any bounds.
+func (condition *interval) holds(subject any) bool {
+	return (condition.lower == nil || condition.lower.admits(condition.compare(condition.lower.value, subject))) &&
+		(condition.upper == nil || condition.upper.admits(condition.compare(subject, condition.upper.value)))
+}
+
+func (condition *interval) format(subject string) string {
+	subject = subjectOrX(subject)
+
+	switch {
+	case condition.lower == nil:
+		return subject + " " + condition.upper.operator("<") + " " + formatValue(condition.upper.value)
+	case condition.upper == nil:
+		return subject + " " + condition.lower.operator(">") + " " + formatValue(condition.lower.value)
+	default:
+		return formatValue(condition.lower.value) + " " + condition.lower.operator("<") + " " + subject + " " +
+			condition.upper.operator("<") + " " + formatValue(condition.upper.value)
+	}
+}
+
+// admits returns true, if the order of the lower value and the upper value is allowed by b.
+func (b *bound) admits(order int) bool {
+	return order < 0 || order == 0 && b.inclusive
+}
+
+// ordered returns false, if a bound is NaN, which is not ordered with respect to any value.
+func (condition *interval) ordered() bool {
+	for _, b := range []*bound{condition.lower, condition.upper} {
+		if b != nil && condition.compare(b.value, b.value) == unordered {
+			return false
+		}
+	}
+
+	return true
+}
+
+// operator returns the strict comparison operator or its inclusive variant.
+func (b *bound) operator(strict string) string {
+	if b.inclusive {
+		return strict + "="
+	}
+
+	return strict
+}
+
+// [Field] returns a [Predicate] over T, which applies the inner predicate to the part of an element selected by get.
+// The name is used to represent the selected part, when the predicate is formatted or analyzed.
+// Nested fields are represented by their names joined by dots, for example "address.city".
+func Field[T, F any](name string, get func(element T) F, inner Predicate[F]) Predicate[T] {
+	return Predicate[T]{node: inner.root().field(name, func(element any) any {
+		return get(element.(T))
+	})}
+}
+
+// field returns a copy of n, where the subjects of all atoms are selected from the result of get.
+func (n *node) field(name string, get func(element any) any) *node {
+	switch n.kind {
+	case kindAtom:
+		inner := n.atom
+
+		return &node{kind: kindAtom, atom: &atom{
+			path: append([]string{name}, inner.path...),
+			subject: func(element any) any {
+				return inner.subject(get(element))
+			},
+			condition: inner.condition,
+		}}
+	case kindNot, kindAnd, kindOr:
+		operands := make([]*node, len(n.operands))
+		for i, operand := range n.operands {
+			operands[i] = operand.field(name, get)
+		}
+
+		return &node{kind: n.kind, operands: operands}
+	default:
+		return n
+	}
+}
+
+func subjectOrX(subject string) string {
+	if subject == "" {
+		return "x"
+	}
+
+	return subject
+}
+
+// formatValue formats values of string types as quoted strings and all other values like [fmt.Print].
+func formatValue(value any) string {
+	if reflect.ValueOf(value).Kind() == reflect.String {
+		return fmt.Sprintf("%q", value)
+	}
+
+	return fmt.Sprint(value)
+}
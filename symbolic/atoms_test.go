@@ -0,0 +1,156 @@
+package symbolic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/symbolic"
+)
+
+type level string
+
+type address struct {
+	City string
+}
+
+type person struct {
+	Name    string
+	Age     int
+	Address address
+}
+
+func (p person) age() int {
+	return p.Age
+}
+
+func (p person) address() address {
+	return p.Address
+}
+
+func (a address) city() string {
+	return a.City
+}
+
+func TestAtoms(t *testing.T) {
+	tests := map[string]struct {
+		predicate symbolic.Predicate[int]
+		expected  string
+		contained []int
+	}{
+		"Eq":          {predicate: symbolic.Eq(3), expected: "x = 3", contained: []int{3}},
+		"Range":       {predicate: symbolic.Range(2, 4), expected: "2 <= x <= 4", contained: []int{2, 3, 4}},
+		"empty Range": {predicate: symbolic.Range(4, 2), expected: "4 <= x <= 2", contained: []int{}},
+		"Ge":          {predicate: symbolic.Ge(8), expected: "x >= 8", contained: []int{8, 9}},
+		"Gt":          {predicate: symbolic.Gt(8), expected: "x > 8", contained: []int{9}},
+		"Le":          {predicate: symbolic.Le(1), expected: "x <= 1", contained: []int{0, 1}},
+		"Lt":          {predicate: symbolic.Lt(1), expected: "x < 1", contained: []int{0}},
+		"In": {
+			predicate: symbolic.In[int]("odd", cantor.NewHashSet(1, 5)),
+			expected:  "in(odd)",
+			contained: []int{1, 5},
+		},
+		"exclusive Ge": {
+			predicate: symbolic.And(symbolic.Gt(1), symbolic.Lt(3)),
+			expected:  "x > 1 AND x < 3",
+			contained: []int{2},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			if test.predicate.String() != test.expected {
+				t.Errorf("expected %q but got %q", test.expected, test.predicate.String())
+			}
+
+			for i := 0; i < 10; i++ {
+				expected := false
+
+				for _, element := range test.contained {
+					expected = expected || element == i
+				}
+
+				if test.predicate.Contains(i) != expected {
+					t.Errorf("expected Contains(%d) to be %v", i, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestAtoms_strings(t *testing.T) {
+	predicate := symbolic.Or(symbolic.Range[level]("a", "b"), symbolic.Eq(level("z")))
+
+	if predicate.String() != `"a" <= x <= "b" OR x = "z"` {
+		t.Errorf("unexpected string %q", predicate.String())
+	}
+
+	if !predicate.Contains("aa") || !predicate.Contains("z") || predicate.Contains("c") {
+		t.Errorf("unexpected results of Contains")
+	}
+}
+
+func TestAtoms_nan(t *testing.T) {
+	nan := math.NaN()
+
+	for _, predicate := range []symbolic.Predicate[float64]{
+		symbolic.Range(0.0, 1.0),
+		symbolic.Ge(0.0),
+		symbolic.Gt(0.0),
+		symbolic.Le(0.0),
+		symbolic.Lt(0.0),
+		symbolic.Eq(nan),
+	} {
+		if predicate.Contains(nan) {
+			t.Errorf("expected %v not to contain NaN", predicate)
+		}
+	}
+
+	for _, predicate := range []symbolic.Predicate[float64]{symbolic.Ge(nan), symbolic.Le(nan), symbolic.Range(nan, 1)} {
+		if predicate.Contains(0) || predicate.Contains(nan) {
+			t.Errorf("expected %v to contain nothing", predicate)
+		}
+	}
+
+	if !symbolic.Not(symbolic.Lt(5.0)).Contains(nan) {
+		t.Errorf("expected the negation of a comparison to contain NaN")
+	}
+}
+
+func TestField(t *testing.T) {
+	hasVoted := cantor.NewHashSet[person]()
+	berlin := symbolic.Field("address", person.address, symbolic.Field("city", address.city, symbolic.Eq("Berlin")))
+	predicate := symbolic.And(
+		symbolic.Field("age", person.age, symbolic.Not(symbolic.Or(symbolic.Lt(18), symbolic.Gt(65)))),
+		symbolic.Not(symbolic.In[person]("hasVoted", hasVoted)),
+		berlin,
+		symbolic.Field("age", person.age, symbolic.True[int]()),
+	)
+
+	expected := `NOT (age < 18 OR age > 65) AND NOT in(hasVoted) AND address.city = "Berlin" AND TRUE`
+	if predicate.String() != expected {
+		t.Errorf("expected %q but got %q", expected, predicate.String())
+	}
+
+	jeff := person{Name: "Jeff", Age: 21, Address: address{City: "Berlin"}}
+	bob := person{Name: "Bob", Age: 17, Address: address{City: "Berlin"}}
+	mary := person{Name: "Mary", Age: 48, Address: address{City: "Hamburg"}}
+
+	if !predicate.Contains(jeff) || predicate.Contains(bob) || predicate.Contains(mary) {
+		t.Errorf("unexpected results of Contains")
+	}
+
+	hasVoted.Add(jeff)
+
+	if predicate.Contains(jeff) {
+		t.Errorf("expected changes of hasVoted to be reflected")
+	}
+
+	admins := symbolic.In[string]("admins", cantor.NewHashSet("Bob"))
+	names := symbolic.Field("name", func(p person) string { return p.Name }, admins)
+	if names.String() != "name in(admins)" || !names.Contains(bob) || names.Contains(jeff) {
+		t.Errorf("unexpected field with In: %s", names)
+	}
+}
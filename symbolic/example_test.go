@@ -0,0 +1,76 @@
+package symbolic_test
+
+import (
+	"fmt"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/testutils"
+	"github.com/frederik-jatzkowski/cantor/symbolic"
+)
+
+// Symbolic predicates can be used like an [cantor.ImplicitSet], but can also be printed.
+func Example() {
+	jeff := testutils.Person{Id: 1, Name: "Jeff", Age: 21}
+	bob := testutils.Person{Id: 3, Name: "Bob", Age: 17}
+	hasVoted := cantor.NewHashSet[testutils.Person]()
+
+	canVote := symbolic.And(
+		symbolic.Field("age", func(p testutils.Person) uint { return p.Age }, symbolic.Ge[uint](18)),
+		symbolic.Not(symbolic.In[testutils.Person]("hasVoted", hasVoted)),
+	)
+
+	citizen := cantor.NewHashSet(jeff, bob)
+
+	fmt.Println(canVote)
+	fmt.Println(citizen.Intersect(canVote))
+
+	hasVoted.Add(jeff)
+
+	fmt.Println(citizen.Intersect(canVote).Size())
+	// Output:
+	// age >= 18 AND NOT in(hasVoted)
+	// {"{1 Jeff 21}"}
+	// 0
+}
+
+// Predicates can be normalized to a disjunction of conjunctions or a conjunction of disjunctions.
+func ExamplePredicate_DNF() {
+	weekend := symbolic.Range(6, 7)
+	holiday := symbolic.In[int]("holidays", cantor.NewHashSet(3))
+	open := symbolic.Not(symbolic.Or(weekend, holiday))
+
+	fmt.Println(open)
+	fmt.Println(open.DNF())
+	fmt.Println(symbolic.And(symbolic.Or(weekend, holiday), symbolic.Le(6)).CNF())
+	// Output:
+	// NOT (6 <= x <= 7 OR in(holidays))
+	// NOT 6 <= x <= 7 AND NOT in(holidays)
+	// (6 <= x <= 7 OR in(holidays)) AND x <= 6
+}
+
+// Rules, which can never match or which are shadowed by an earlier rule, can be detected before they are used.
+func ExampleImplies() {
+	age := func(p testutils.Person) uint { return p.Age }
+	rules := []symbolic.Predicate[testutils.Person]{
+		symbolic.Field("age", age, symbolic.Ge[uint](18)),
+		symbolic.Field("age", age, symbolic.Range[uint](21, 30)),
+		symbolic.Field("age", age, symbolic.And(symbolic.Lt[uint](16), symbolic.Gt[uint](15))),
+	}
+
+	for i, rule := range rules {
+		if symbolic.IsEmpty(rule) {
+			fmt.Printf("%s never matches\n", rule)
+
+			continue
+		}
+
+		for _, earlier := range rules[:i] {
+			if symbolic.Implies(rule, earlier) {
+				fmt.Printf("%s is shadowed by %s\n", rule, earlier)
+			}
+		}
+	}
+	// Output:
+	// 21 <= age <= 30 is shadowed by age >= 18
+	// age < 16 AND age > 15 never matches
+}
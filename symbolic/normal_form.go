@@ -0,0 +1,215 @@
+package symbolic
+
+// literal is an atom or its negation.
+type literal struct {
+	atom    *atom
+	negated bool
+	// key identifies equivalent atoms.
+	key string
+}
+
+// term is a conjunction of literals in a disjunctive normal form
+// or a disjunction of literals in a conjunctive normal form.
+type term []literal
+
+// DNF returns an equivalent [Predicate] in disjunctive normal form, which is a disjunction of conjunctions of atoms
+// and negated atoms, for example "a AND NOT b OR c".
+//
+// Contradictory and redundant conjunctions are removed.
+// Note that the size of the result can grow exponentially with the size of this [Predicate].
+func (predicate Predicate[T]) DNF() Predicate[T] {
+	return Predicate[T]{node: build(predicate.root().dnf(false), kindOr, kindAnd)}
+}
+
+// CNF returns an equivalent [Predicate] in conjunctive normal form, which is a conjunction of disjunctions of atoms
+// and negated atoms, for example "(a OR NOT b) AND c".
+//
+// Tautological and redundant disjunctions are removed.
+// Note that the size of the result can grow exponentially with the size of this [Predicate].
+func (predicate Predicate[T]) CNF() Predicate[T] {
+	// the CNF of a predicate is the negated DNF of its negation
+	terms := predicate.root().dnf(true)
+	for _, term := range terms {
+		for i := range term {
+			term[i].negated = !term[i].negated
+		}
+	}
+
+	return Predicate[T]{node: build(terms, kindAnd, kindOr)}
+}
+
+// duals maps the kinds of nodes to the kinds of their negations by De Morgan's laws.
+// Atoms and negations keep their kind, since dnf negates them using literals.
+var duals = map[nodeKind]nodeKind{
+	kindTrue:  kindFalse,
+	kindFalse: kindTrue,
+	kindAtom:  kindAtom,
+	kindNot:   kindNot,
+	kindAnd:   kindOr,
+	kindOr:    kindAnd,
+}
+
+// dnf returns the simplified conjunctions of the disjunctive normal form of n or its negation.
+func (n *node) dnf(negated bool) []term {
+	kind := n.kind
+	if negated {
+		kind = duals[kind]
+	}
+
+	switch kind {
+	case kindTrue:
+		return []term{{}}
+	case kindFalse:
+		return nil
+	case kindAtom:
+		return []term{{literal{atom: n.atom, negated: negated, key: n.atom.String()}}}
+	case kindNot:
+		return n.operands[0].dnf(!negated)
+	case kindAnd:
+		return n.conjunction(negated)
+	default:
+		return n.disjunction(negated)
+	}
+}
+
+// conjunction returns the products of the disjunctive normal forms of all operands or their negations.
+func (n *node) conjunction(negated bool) []term {
+	terms := []term{{}}
+	for _, operand := range n.operands {
+		terms = product(terms, operand.dnf(negated))
+	}
+
+	return terms
+}
+
+// disjunction returns the simplified conjunctions of the disjunctive normal forms of all operands or their negations.
+func (n *node) disjunction(negated bool) []term {
+	var terms []term
+	for _, operand := range n.operands {
+		terms = append(terms, operand.dnf(negated)...)
+	}
+
+	return simplify(terms)
+}
+
+// product returns the conjunctions of all pairs of conjunctions.
+func product(a, b []term) []term {
+	var terms []term
+
+	for _, left := range a {
+		for _, right := range b {
+			combined, ok := conjoin(left, right)
+			if ok {
+				terms = append(terms, combined)
+			}
+		}
+	}
+
+	return simplify(terms)
+}
+
+// conjoin returns the conjunction of a and b without duplicate literals.
+// It returns false, if the result is contradictory.
+func conjoin(a, b term) (term, bool) {
+	result := append(term{}, a...)
+
+	for _, right := range b {
+		duplicate := false
+
+		for _, left := range a {
+			if left.key != right.key {
+				continue
+			}
+
+			if left.negated != right.negated {
+				return nil, false
+			}
+
+			duplicate = true
+		}
+
+		if !duplicate {
+			result = append(result, right)
+		}
+	}
+
+	return result, true
+}
+
+// simplify removes all terms, which are implied by another term.
+func simplify(terms []term) []term {
+	result := make([]term, 0, len(terms))
+
+	for i, candidate := range terms {
+		redundant := false
+
+		for j, other := range terms {
+			if i != j && other.includedIn(candidate) && (len(other) < len(candidate) || j < i) {
+				redundant = true
+
+				break
+			}
+		}
+
+		if !redundant {
+			result = append(result, candidate)
+		}
+	}
+
+	return result
+}
+
+// includedIn returns true, if all literals of t are contained in other.
+func (t term) includedIn(other term) bool {
+	for _, a := range t {
+		found := false
+
+		for _, b := range other {
+			if a.key == b.key && a.negated == b.negated {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// build returns the node representing terms, which are combined using outer and consist of literals combined by inner.
+func build(terms []term, outer, inner nodeKind) *node {
+	operands := make([]*node, len(terms))
+
+	for i, term := range terms {
+		literals := make([]*node, len(term))
+
+		for j, literal := range term {
+			literals[j] = &node{kind: kindAtom, atom: literal.atom}
+			if literal.negated {
+				literals[j] = &node{kind: kindNot, operands: []*node{literals[j]}}
+			}
+		}
+
+		operands[i] = combineNodes(inner, literals)
+	}
+
+	return combineNodes(outer, operands)
+}
+
+// combineNodes returns the combination of nodes or the neutral element of kind, if there are no nodes.
+func combineNodes(kind nodeKind, nodes []*node) *node {
+	switch {
+	case len(nodes) == 1:
+		return nodes[0]
+	case len(nodes) == 0 && kind == kindAnd:
+		return trueNode
+	case len(nodes) == 0:
+		return falseNode
+	default:
+		return &node{kind: kind, operands: nodes}
+	}
+}
@@ -0,0 +1,101 @@
+package symbolic_test
+
+import (
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/symbolic"
+)
+
+func TestPredicate_normalForms(t *testing.T) {
+	a := symbolic.In[int]("a", cantor.NewImplicitSet(func(i int) bool { return i%2 == 0 }))
+	b := symbolic.In[int]("b", cantor.NewImplicitSet(func(i int) bool { return i%3 == 0 }))
+	c := symbolic.In[int]("c", cantor.NewImplicitSet(func(i int) bool { return i%5 == 0 }))
+
+	tests := map[string]struct {
+		predicate symbolic.Predicate[int]
+		dnf       string
+		cnf       string
+	}{
+		"atom": {
+			predicate: a,
+			dnf:       "in(a)",
+			cnf:       "in(a)",
+		},
+		"true": {
+			predicate: symbolic.True[int](),
+			dnf:       "TRUE",
+			cnf:       "TRUE",
+		},
+		"false": {
+			predicate: symbolic.Not(symbolic.True[int]()),
+			dnf:       "FALSE",
+			cnf:       "FALSE",
+		},
+		"negated false": {
+			predicate: symbolic.And(a, symbolic.Not(symbolic.False[int]())),
+			dnf:       "in(a)",
+			cnf:       "in(a)",
+		},
+		"distribution": {
+			predicate: symbolic.And(symbolic.Or(a, b), c),
+			dnf:       "in(a) AND in(c) OR in(b) AND in(c)",
+			cnf:       "(in(a) OR in(b)) AND in(c)",
+		},
+		"de morgan": {
+			predicate: symbolic.Not(symbolic.And(a, symbolic.Or(b, symbolic.Not(c)))),
+			dnf:       "NOT in(a) OR NOT in(b) AND in(c)",
+			cnf:       "(NOT in(a) OR NOT in(b)) AND (NOT in(a) OR in(c))",
+		},
+		"contradiction": {
+			predicate: symbolic.And(a, symbolic.Not(a)),
+			dnf:       "FALSE",
+			cnf:       "in(a) AND NOT in(a)",
+		},
+		"tautology": {
+			predicate: symbolic.Or(a, symbolic.Not(a)),
+			dnf:       "in(a) OR NOT in(a)",
+			cnf:       "TRUE",
+		},
+		"duplicates": {
+			predicate: symbolic.And(symbolic.Or(a, b), symbolic.Or(b, a), a),
+			dnf:       "in(a)",
+			cnf:       "in(a)",
+		},
+		"absorption": {
+			predicate: symbolic.Or(symbolic.And(a, b, c), symbolic.And(b, a), symbolic.And(c, b)),
+			dnf:       "in(b) AND in(a) OR in(c) AND in(b)",
+			cnf:       "(in(a) OR in(c)) AND in(b)",
+		},
+		"equivalent atoms": {
+			predicate: symbolic.And(symbolic.Ge(3), symbolic.Or(symbolic.Ge(3), symbolic.Eq(1))),
+			dnf:       "x >= 3",
+			cnf:       "x >= 3",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			dnf := test.predicate.DNF()
+			cnf := test.predicate.CNF()
+
+			if dnf.String() != test.dnf {
+				t.Errorf("expected DNF %q but got %q", test.dnf, dnf)
+			}
+
+			if cnf.String() != test.cnf {
+				t.Errorf("expected CNF %q but got %q", test.cnf, cnf)
+			}
+
+			for i := 0; i < 30; i++ {
+				expected := test.predicate.Contains(i)
+
+				if dnf.Contains(i) != expected || cnf.Contains(i) != expected {
+					t.Errorf("normal forms are not equivalent for %d", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package symbolic
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+)
+
+// span is a connected part of a region. Missing bounds are unbounded.
+type span struct {
+	lower, upper *bound
+}
+
+// region is a union of disjoint spans of ordered values.
+type region struct {
+	spans   []span
+	compare func(a, b any) int
+	// integer is true, if the values are integers represented by *big.Int and all bounds are inclusive.
+	integer bool
+}
+
+// infiniteSatisfiable returns true, if a value of an infinite or large domain satisfies all literals,
+// which are positive or negative intervals and negative equalities.
+func infiniteSatisfiable(literals []literal) bool {
+	value := sample(literals)
+	if nan, ok := notANumber(value); ok && satisfies(nan, literals) {
+		// floating point numbers are not totally ordered, since NaN satisfies only negated comparisons
+		return true
+	}
+
+	r := newRegion(value, literals)
+	if r == nil {
+		// the values are not ordered and only finitely many values are excluded
+		return true
+	}
+
+	excluded := map[any]bool{}
+
+	for _, literal := range literals {
+		switch condition := literal.atom.condition.(type) {
+		case *interval:
+			if !r.restrict(condition, literal.negated) {
+				return false
+			}
+		case *eq:
+			excluded[condition.value] = true
+		}
+	}
+
+	return r.exceeds(excluded)
+}
+
+// notANumber returns NaN of the type of value, if it is a floating point type.
+func notANumber(value any) (any, bool) {
+	kind := reflect.ValueOf(value).Kind()
+	if kind != reflect.Float32 && kind != reflect.Float64 {
+		return nil, false
+	}
+
+	return reflect.ValueOf(math.NaN()).Convert(reflect.TypeOf(value)).Interface(), true
+}
+
+// newRegion returns the region of all values of the type of value or nil, if the values are not ordered.
+func newRegion(value any, literals []literal) *region {
+	bits := uint(0)
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bits = uint(reflect.TypeOf(value).Bits())
+		limit := new(big.Int).Lsh(big.NewInt(1), bits-1)
+
+		return &region{
+			spans: []span{{
+				lower: &bound{value: new(big.Int).Neg(limit), inclusive: true},
+				upper: &bound{value: limit.Sub(limit, big.NewInt(1)), inclusive: true},
+			}},
+			compare: compareIntegers,
+			integer: true,
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		bits = uint(reflect.TypeOf(value).Bits())
+		limit := new(big.Int).Lsh(big.NewInt(1), bits)
+
+		return &region{
+			spans: []span{{
+				lower: &bound{value: big.NewInt(0), inclusive: true},
+				upper: &bound{value: limit.Sub(limit, big.NewInt(1)), inclusive: true},
+			}},
+			compare: compareIntegers,
+			integer: true,
+		}
+	}
+
+	for _, literal := range literals {
+		if condition, ok := literal.atom.condition.(*interval); ok {
+			return &region{spans: []span{{}}, compare: condition.compare}
+		}
+	}
+
+	return nil
+}
+
+func compareIntegers(a, b any) int {
+	return a.(*big.Int).Cmp(b.(*big.Int))
+}
+
+// toInteger converts a value of an integer type to *big.Int.
+func toInteger(value any) *big.Int {
+	reflected := reflect.ValueOf(value)
+	if reflected.CanInt() {
+		return big.NewInt(reflected.Int())
+	}
+
+	return new(big.Int).SetUint64(reflected.Uint())
+}
+
+// span returns the span of all values satisfying the condition.
+// For integers, exclusive bounds are converted to inclusive bounds.
+func (r *region) span(condition *interval) span {
+	if !r.integer {
+		return span{lower: condition.lower, upper: condition.upper}
+	}
+
+	var result span
+
+	if condition.lower != nil {
+		value := toInteger(condition.lower.value)
+		if !condition.lower.inclusive {
+			value.Add(value, big.NewInt(1))
+		}
+
+		result.lower = &bound{value: value, inclusive: true}
+	}
+
+	if condition.upper != nil {
+		value := toInteger(condition.upper.value)
+		if !condition.upper.inclusive {
+			value.Sub(value, big.NewInt(1))
+		}
+
+		result.upper = &bound{value: value, inclusive: true}
+	}
+
+	return result
+}
+
+// restrict removes all values from r, which do not satisfy the condition or its negation.
+// It returns false, if no value can satisfy the condition, because one of its bounds is NaN.
+func (r *region) restrict(condition *interval, negated bool) bool {
+	switch {
+	case !condition.ordered():
+		return negated
+	case negated:
+		r.subtract(r.span(condition))
+	default:
+		r.intersect(r.span(condition))
+	}
+
+	return true
+}
+
+// intersect removes all values outside of s from r.
+func (r *region) intersect(s span) {
+	spans := r.spans[:0]
+
+	for _, current := range r.spans {
+		current = span{lower: r.tighter(current.lower, s.lower, 1), upper: r.tighter(current.upper, s.upper, -1)}
+		if r.nonEmpty(current) {
+			spans = append(spans, current)
+		}
+	}
+
+	r.spans = spans
+}
+
+// subtract removes all values inside of s from r.
+func (r *region) subtract(s span) {
+	var spans []span
+
+	for _, current := range r.spans {
+		if s.lower != nil {
+			below := span{lower: current.lower, upper: r.tighter(current.upper, r.complement(s.lower), -1)}
+			if r.nonEmpty(below) {
+				spans = append(spans, below)
+			}
+		}
+
+		if s.upper != nil {
+			above := span{lower: r.tighter(current.lower, r.complement(s.upper), 1), upper: current.upper}
+			if r.nonEmpty(above) {
+				spans = append(spans, above)
+			}
+		}
+	}
+
+	r.spans = spans
+}
+
+// complement returns the bound of the values on the other side of b.
+func (r *region) complement(b *bound) *bound {
+	// exclusive integer bounds are converted to inclusive bounds by tighter
+	return &bound{value: b.value, inclusive: !b.inclusive}
+}
+
+// tighter returns the more restrictive bound. The direction is 1 for lower bounds and -1 for upper bounds.
+func (r *region) tighter(a, b *bound, direction int) *bound {
+	a, b = r.normalize(a, direction), r.normalize(b, direction)
+
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	}
+
+	order := r.compare(a.value, b.value) * direction
+
+	switch {
+	case order > 0:
+		return a
+	case order < 0:
+		return b
+	default:
+		return &bound{value: a.value, inclusive: a.inclusive && b.inclusive}
+	}
+}
+
+// normalize converts exclusive integer bounds to inclusive bounds.
+func (r *region) normalize(b *bound, direction int) *bound {
+	if b == nil || !r.integer || b.inclusive {
+		return b
+	}
+
+	return &bound{value: new(big.Int).Add(b.value.(*big.Int), big.NewInt(int64(direction))), inclusive: true}
+}
+
+func (r *region) nonEmpty(s span) bool {
+	if s.lower == nil || s.upper == nil {
+		return true
+	}
+
+	order := r.compare(s.lower.value, s.upper.value)
+
+	return order < 0 || order == 0 && s.lower.inclusive && s.upper.inclusive
+}
+
+func (r *region) contains(value any) bool {
+	if r.integer {
+		value = toInteger(value)
+	}
+
+	point := &bound{value: value, inclusive: true}
+
+	for _, s := range r.spans {
+		if r.nonEmpty(span{lower: s.lower, upper: point}) && r.nonEmpty(span{lower: point, upper: s.upper}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exceeds returns true, if r contains a value, which is not excluded.
+func (r *region) exceeds(excluded map[any]bool) bool {
+	count := 0
+
+	for value := range excluded {
+		if r.contains(value) {
+			count++
+		}
+	}
+
+	if r.integer {
+		return r.size().Cmp(big.NewInt(int64(count))) > 0
+	}
+
+	for _, s := range r.spans {
+		if s.lower == nil || s.upper == nil || r.compare(s.lower.value, s.upper.value) != 0 {
+			// dense spans with different bounds contain infinitely many values
+			return true
+		}
+	}
+
+	return len(r.spans) > count
+}
+
+// size returns the number of integers in r.
+func (r *region) size() *big.Int {
+	size := new(big.Int)
+
+	for _, s := range r.spans {
+		size.Add(size, new(big.Int).Sub(s.upper.value.(*big.Int), s.lower.value.(*big.Int)))
+		size.Add(size, big.NewInt(1))
+	}
+
+	return size
+}
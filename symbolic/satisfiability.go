@@ -0,0 +1,165 @@
+package symbolic
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+// [Constraint] restricts the values of a subject for [IsEmpty], [IsUniversal] and [Implies].
+type Constraint struct {
+	path   string
+	values []any
+}
+
+// [Within] constrains the subject selected by path to the elements of a finite domain, for example a [cantor.Universe]
+// of enum constants. The path consists of the names of nested fields joined by dots, like "address.city",
+// or is empty for the element itself. Values outside of the domain are ignored by the analysis.
+//
+// The elements of the domain must have the type of the subject.
+func Within[F any](path string, domain cantor.Domain[F]) Constraint {
+	constraint := Constraint{path: path, values: []any{}}
+
+	domain.Elements()(func(element F) bool {
+		constraint.values = append(constraint.values, element)
+
+		return true
+	})
+
+	return constraint
+}
+
+// [IsEmpty] returns true, if no element can satisfy the predicate.
+//
+// The analysis considers the domains of the subjects of all atoms:
+//   - values of integer types are bounded by their type,
+//   - values of boolean types are false or true,
+//   - values can be restricted to a finite domain using [Within].
+//
+// Containers referenced by [In] are treated as arbitrary sets, which are only identified by their name.
+// Thus, the result does not depend on their current contents.
+// Ranges of floating point numbers and strings are treated as dense, which means that a range
+// with different bounds is never considered empty.
+// Since NaN satisfies no comparison, floating point numbers are not totally ordered.
+// For example, x < 5 OR x >= 5 is not universal.
+//
+// The predicate is normalized using [Predicate.DNF], so the analysis can take exponential time.
+func IsEmpty[T any](predicate Predicate[T], constraints ...Constraint) bool {
+	domains := make(map[string][]any, len(constraints))
+	for _, constraint := range constraints {
+		domains[constraint.path] = constraint.values
+	}
+
+	for _, term := range predicate.root().dnf(false) {
+		if term.satisfiable(domains) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// [IsUniversal] returns true, if every element satisfies the predicate.
+// See [IsEmpty] for the semantics of the analysis.
+func IsUniversal[T any](predicate Predicate[T], constraints ...Constraint) bool {
+	return IsEmpty(Not(predicate), constraints...)
+}
+
+// [Implies] returns true, if every element satisfying a also satisfies b.
+// This can be used to detect rules, which are shadowed by a more general rule.
+// See [IsEmpty] for the semantics of the analysis.
+func Implies[T any](a, b Predicate[T], constraints ...Constraint) bool {
+	return IsEmpty(And(a, Not(b)), constraints...)
+}
+
+// satisfiable returns true, if the conjunction of all literals of t can be satisfied.
+// Since the terms of a DNF are free of contradictions, only literals on the same subject can conflict.
+func (t term) satisfiable(domains map[string][]any) bool {
+	subjects := map[string][]literal{}
+
+	for _, literal := range t {
+		switch literal.atom.condition.(type) {
+		case *eq, *interval:
+			path := strings.Join(literal.atom.path, ".")
+			subjects[path] = append(subjects[path], literal)
+		}
+	}
+
+	for path, literals := range subjects {
+		if !satisfiable(literals, domains[path]) {
+			return false
+		}
+	}
+
+	for path, domain := range domains {
+		if len(domain) == 0 && subjects[path] == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// satisfiable returns true, if a value within domain satisfies all literals.
+// A nil domain does not restrict the values.
+func satisfiable(literals []literal, domain []any) bool {
+	if domain == nil {
+		domain = finiteCandidates(literals)
+	}
+
+	if domain == nil {
+		return infiniteSatisfiable(literals)
+	}
+
+	for _, value := range domain {
+		if satisfies(value, literals) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// finiteCandidates returns the only values, which can satisfy all literals, or nil if there are infinitely many.
+func finiteCandidates(literals []literal) []any {
+	for _, literal := range literals {
+		condition, ok := literal.atom.condition.(*eq)
+		if ok && !literal.negated {
+			return []any{condition.value}
+		}
+	}
+
+	value := sample(literals)
+	if reflect.ValueOf(value).Kind() == reflect.Bool {
+		typ := reflect.TypeOf(value)
+
+		return []any{reflect.Zero(typ).Interface(), reflect.ValueOf(true).Convert(typ).Interface()}
+	}
+
+	return nil
+}
+
+func satisfies(value any, literals []literal) bool {
+	for _, literal := range literals {
+		if literal.atom.condition.holds(value) == literal.negated {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sample returns a value of the type of the subject of literals.
+func sample(literals []literal) any {
+	if condition, ok := literals[0].atom.condition.(*eq); ok {
+		return condition.value
+	}
+
+	condition := literals[0].atom.condition.(*interval)
+	if condition.lower != nil {
+		return condition.lower.value
+	}
+
+	return condition.upper.value
+}
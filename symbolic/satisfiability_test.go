@@ -0,0 +1,257 @@
+package symbolic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/enums"
+	"github.com/frederik-jatzkowski/cantor/symbolic"
+)
+
+type flag bool
+
+type point struct {
+	X, Y int
+}
+
+func TestIsEmpty(t *testing.T) {
+	banned := symbolic.In[int]("banned", cantor.NewHashSet(1, 2, 3))
+
+	tests := map[string]struct {
+		predicate symbolic.Predicate[int]
+		expected  bool
+	}{
+		"true":                {predicate: symbolic.True[int](), expected: false},
+		"false":               {predicate: symbolic.False[int](), expected: true},
+		"contradiction":       {predicate: symbolic.And(banned, symbolic.Not(banned)), expected: true},
+		"independent atoms":   {predicate: symbolic.And(banned, symbolic.Eq(5)), expected: false},
+		"different values":    {predicate: symbolic.And(symbolic.Eq(1), symbolic.Eq(2)), expected: true},
+		"value outside range": {predicate: symbolic.And(symbolic.Eq(1), symbolic.Ge(2)), expected: true},
+		"value inside range":  {predicate: symbolic.And(symbolic.Eq(2), symbolic.Ge(2)), expected: false},
+		"disjoint ranges":     {predicate: symbolic.And(symbolic.Lt(18), symbolic.Ge(18)), expected: true},
+		"empty range":         {predicate: symbolic.Range(5, 4), expected: true},
+		"exclusive bounds":    {predicate: symbolic.And(symbolic.Gt(4), symbolic.Lt(5)), expected: true},
+		"single value":        {predicate: symbolic.And(symbolic.Gt(4), symbolic.Lt(6)), expected: false},
+		"excluded single value": {
+			predicate: symbolic.And(symbolic.Range(4, 5), symbolic.Not(symbolic.Eq(4)), symbolic.Not(symbolic.Eq(5))),
+			expected:  true,
+		},
+		"excluded outside": {
+			predicate: symbolic.And(symbolic.Range(4, 5), symbolic.Not(symbolic.Eq(4)), symbolic.Not(symbolic.Eq(6))),
+			expected:  false,
+		},
+		"negated range": {
+			predicate: symbolic.And(symbolic.Range(0, 10), symbolic.Not(symbolic.Range(0, 10))),
+			expected:  true,
+		},
+		"covering negations": {
+			predicate: symbolic.And(symbolic.Not(symbolic.Lt(0)), symbolic.Not(symbolic.Ge(0))),
+			expected:  true,
+		},
+		"punctured range": {
+			predicate: symbolic.And(symbolic.Range(0, 10), symbolic.Not(symbolic.Range(1, 9))),
+			expected:  false,
+		},
+		"kind bounds": {
+			predicate: symbolic.And(symbolic.Not(symbolic.Lt(0)), symbolic.Not(symbolic.Eq(0))),
+			expected:  false,
+		},
+		"one of many": {
+			predicate: symbolic.Or(symbolic.Eq(1).DNF(), symbolic.And(symbolic.Eq(1), symbolic.Eq(2))),
+			expected:  false,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			if symbolic.IsEmpty(test.predicate) != test.expected {
+				t.Errorf("expected IsEmpty(%s) to be %v", test.predicate, test.expected)
+			}
+		})
+	}
+}
+
+func TestIsEmpty_types(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
+		predicate := symbolic.Not(symbolic.Range[int8](-127, 127))
+		if symbolic.IsEmpty(predicate) || !symbolic.IsEmpty(symbolic.And(predicate, symbolic.Not(symbolic.Eq[int8](-128)))) {
+			t.Errorf("expected int8 to be bounded by -128 and 127")
+		}
+	})
+
+	t.Run("uint8", func(t *testing.T) {
+		predicate := symbolic.Not(symbolic.Le[uint8](254))
+		if symbolic.IsEmpty(predicate) || !symbolic.IsEmpty(symbolic.And(predicate, symbolic.Not(symbolic.Eq[uint8](255)))) {
+			t.Errorf("expected uint8 to be bounded by 0 and 255")
+		}
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		if symbolic.IsEmpty(symbolic.Gt[uint64](1<<63)) || !symbolic.IsEmpty(symbolic.Gt[uint64](1<<64-1)) {
+			t.Errorf("expected uint64 to be bounded by 0 and 2^64-1")
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		predicate := symbolic.And(symbolic.Not(symbolic.Eq(flag(true))), symbolic.Not(symbolic.Eq(flag(false))))
+		if !symbolic.IsEmpty(predicate) || symbolic.IsEmpty(symbolic.Not(symbolic.Eq(flag(true)))) {
+			t.Errorf("expected bool to have two values")
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		cases := map[symbolic.Predicate[float64]]bool{
+			symbolic.And(symbolic.Gt(0.0), symbolic.Lt(1e-300)):                              false,
+			symbolic.And(symbolic.Ge(1.0), symbolic.Le(1.0)):                                 false,
+			symbolic.And(symbolic.Ge(1.0), symbolic.Le(1.0), symbolic.Not(symbolic.Eq(1.0))): true,
+			symbolic.And(symbolic.Gt(1.0), symbolic.Le(1.0)):                                 true,
+			symbolic.And(symbolic.Le(1.0), symbolic.Not(symbolic.Lt(1.0))):                   false,
+			symbolic.And(symbolic.Not(symbolic.Eq(1.0)), symbolic.Not(symbolic.Eq(2.0))):     false,
+			symbolic.Not(symbolic.Ge(1.0)):                                                   false,
+			symbolic.And(symbolic.Not(symbolic.Lt(5.0)), symbolic.Not(symbolic.Ge(5.0))):     false,
+			symbolic.Eq(math.NaN()):                                                          true,
+			symbolic.Ge(math.NaN()):                                                          true,
+			symbolic.And(symbolic.Not(symbolic.Ge(math.NaN())), symbolic.Ge(1.0)):            false,
+		}
+
+		for predicate, expected := range cases {
+			if symbolic.IsEmpty(predicate) != expected {
+				t.Errorf("expected IsEmpty(%s) to be %v", predicate, expected)
+			}
+		}
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		if symbolic.IsUniversal(symbolic.Or(symbolic.Lt[float32](5), symbolic.Ge[float32](5))) {
+			t.Errorf("expected NaN to satisfy neither comparison")
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		if !symbolic.IsEmpty(symbolic.And(symbolic.Lt("a"), symbolic.Gt("b"))) || symbolic.IsEmpty(symbolic.Range("a", "b")) {
+			t.Errorf("unexpected results for strings")
+		}
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		predicate := symbolic.And(symbolic.Not(symbolic.Eq(point{})), symbolic.Not(symbolic.Eq(point{X: 1})))
+		if symbolic.IsEmpty(predicate) || !symbolic.IsEmpty(symbolic.And(symbolic.Eq(point{}), symbolic.Eq(point{X: 1}))) {
+			t.Errorf("unexpected results for structs")
+		}
+	})
+}
+
+func TestIsEmpty_bruteForce(t *testing.T) {
+	atoms := []symbolic.Predicate[int8]{
+		symbolic.Eq[int8](0), symbolic.Eq[int8](-128), symbolic.Ge[int8](-2), symbolic.Gt[int8](3),
+		symbolic.Le[int8](3), symbolic.Lt[int8](-3), symbolic.Range[int8](-1, 1), symbolic.Range[int8](126, 127),
+	}
+
+	for i, a := range atoms {
+		for j, b := range atoms {
+			for k, c := range atoms {
+				predicates := []symbolic.Predicate[int8]{
+					symbolic.And(a, symbolic.Not(b), c),
+					symbolic.And(symbolic.Not(a), symbolic.Not(b), symbolic.Not(c)),
+					symbolic.And(a, symbolic.Or(symbolic.Not(b), c)),
+				}
+
+				for _, predicate := range predicates {
+					expected := true
+
+					for value := -128; value < 128; value++ {
+						expected = expected && !predicate.Contains(int8(value))
+					}
+
+					if symbolic.IsEmpty(predicate) != expected {
+						t.Errorf("%d %d %d: expected IsEmpty(%s) to be %v", i, j, k, predicate, expected)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestIsEmpty_within(t *testing.T) {
+	role := func(r enums.Role) enums.Role { return r }
+	roles := symbolic.Within[enums.Role]("role", enums.RoleUniverse)
+	notAdminOrEditor := symbolic.And(
+		symbolic.Not(symbolic.Eq(enums.Admin)),
+		symbolic.Not(symbolic.Eq(enums.Editor)),
+		symbolic.Not(symbolic.Eq(enums.Viewer)),
+	)
+
+	predicate := symbolic.Field("role", role, notAdminOrEditor)
+	if symbolic.IsEmpty(predicate, roles) {
+		t.Errorf("expected Guest to satisfy %s", predicate)
+	}
+
+	predicate = symbolic.And(predicate, symbolic.Field("role", role, symbolic.Not(symbolic.Eq(enums.Guest))))
+	if !symbolic.IsEmpty(predicate, roles) || symbolic.IsEmpty(predicate) {
+		t.Errorf("expected %s to be empty only within roles", predicate)
+	}
+
+	if !symbolic.IsEmpty(symbolic.Field("role", role, symbolic.Eq(enums.Role(42))), roles) {
+		t.Errorf("expected values outside of the domain to be ignored")
+	}
+
+	empty := symbolic.Within[int]("other", cantor.NewHashSet[int]())
+	if !symbolic.IsEmpty(symbolic.True[int](), empty) {
+		t.Errorf("expected an empty domain to contain no elements")
+	}
+}
+
+func TestIsUniversal(t *testing.T) {
+	tests := map[string]struct {
+		predicate symbolic.Predicate[uint8]
+		expected  bool
+	}{
+		"true":           {predicate: symbolic.True[uint8](), expected: true},
+		"excluded value": {predicate: symbolic.Not(symbolic.Eq[uint8](1)), expected: false},
+		"split":          {predicate: symbolic.Or(symbolic.Lt[uint8](10), symbolic.Ge[uint8](10)), expected: true},
+		"kind bounds":    {predicate: symbolic.Range[uint8](0, 255), expected: true},
+		"gap":            {predicate: symbolic.Or(symbolic.Lt[uint8](10), symbolic.Gt[uint8](10)), expected: false},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			if symbolic.IsUniversal(test.predicate) != test.expected {
+				t.Errorf("expected IsUniversal(%s) to be %v", test.predicate, test.expected)
+			}
+		})
+	}
+}
+
+func TestImplies(t *testing.T) {
+	age := func(p person) int { return p.Age }
+	admins := symbolic.In[person]("admins", cantor.NewHashSet[person]())
+	adults := symbolic.Field("age", age, symbolic.Ge(18))
+	seniors := symbolic.Field("age", age, symbolic.Ge(65))
+
+	tests := map[string]struct {
+		a, b     symbolic.Predicate[person]
+		expected bool
+	}{
+		"seniors are adults":          {a: seniors, b: adults, expected: true},
+		"adults are not seniors":      {a: adults, b: seniors, expected: false},
+		"admins are not adults":       {a: admins, b: adults, expected: false},
+		"adult admins are adults":     {a: symbolic.And(admins, adults), b: adults, expected: true},
+		"adults are adults or admins": {a: adults, b: symbolic.Or(admins, adults), expected: true},
+		"false implies everything":    {a: symbolic.False[person](), b: admins, expected: true},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			if symbolic.Implies(test.a, test.b) != test.expected {
+				t.Errorf("expected Implies(%s, %s) to be %v", test.a, test.b, test.expected)
+			}
+		})
+	}
+}
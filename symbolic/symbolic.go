@@ -0,0 +1,204 @@
+// Package symbolic implements predicates with structure instead of opaque closures.
+//
+// A [Predicate] is built from atoms like [Eq], [In] or [Range], which can be applied to parts
+// of an element using [Field], and the combinators [And], [Or] and [Not].
+// Unlike a [cantor.ImplicitSet], a [Predicate] can be printed, normalized and analyzed:
+//
+//	canVote := symbolic.And(
+//		symbolic.Field("age", Person.GetAge, symbolic.Ge(18)),
+//		symbolic.Not(symbolic.In("hasVoted", hasVoted)),
+//	)
+//
+//	fmt.Println(canVote) // age >= 18 AND NOT in(hasVoted)
+//
+// [Predicate] implements [cantor.Container], so it can be used wherever a container is expected.
+package symbolic
+
+import (
+	"strings"
+
+	"github.com/frederik-jatzkowski/cantor"
+)
+
+var _ cantor.Container[int] = Predicate[int]{}
+
+// [Predicate] is a symbolic predicate over elements of type T.
+// Predicates are immutable and safe for concurrent use, as long as the containers referenced by [In] are.
+//
+// The zero value is the predicate [True], which contains every element.
+type Predicate[T any] struct {
+	node *node
+}
+
+type nodeKind int
+
+const (
+	kindTrue nodeKind = iota
+	kindFalse
+	kindAtom
+	kindNot
+	kindAnd
+	kindOr
+)
+
+// node is a type erased node of the syntax tree of a [Predicate].
+type node struct {
+	kind     nodeKind
+	atom     *atom
+	operands []*node
+}
+
+var (
+	trueNode  = &node{kind: kindTrue}
+	falseNode = &node{kind: kindFalse}
+)
+
+// [True] returns a [Predicate], which contains every element.
+func True[T any]() Predicate[T] {
+	return Predicate[T]{node: trueNode}
+}
+
+// [False] returns a [Predicate], which contains no element.
+func False[T any]() Predicate[T] {
+	return Predicate[T]{node: falseNode}
+}
+
+// [And] returns a [Predicate], which contains all elements contained in every operand.
+// Without operands, it returns [True].
+func And[T any](operands ...Predicate[T]) Predicate[T] {
+	return Predicate[T]{node: combine(kindAnd, operands)}
+}
+
+// [Or] returns a [Predicate], which contains all elements contained in at least one operand.
+// Without operands, it returns [False].
+func Or[T any](operands ...Predicate[T]) Predicate[T] {
+	return Predicate[T]{node: combine(kindOr, operands)}
+}
+
+// [Not] returns a [Predicate], which contains all elements not contained in the operand.
+func Not[T any](operand Predicate[T]) Predicate[T] {
+	return Predicate[T]{node: &node{kind: kindNot, operands: []*node{operand.root()}}}
+}
+
+// combine flattens nested operands of the same kind.
+func combine[T any](kind nodeKind, operands []Predicate[T]) *node {
+	nodes := make([]*node, 0, len(operands))
+
+	for _, operand := range operands {
+		root := operand.root()
+		if root.kind == kind {
+			nodes = append(nodes, root.operands...)
+		} else {
+			nodes = append(nodes, root)
+		}
+	}
+
+	return combineNodes(kind, nodes)
+}
+
+func (predicate Predicate[T]) root() *node {
+	if predicate.node == nil {
+		return trueNode
+	}
+
+	return predicate.node
+}
+
+// Contains returns true, if the element satisfies this [Predicate].
+func (predicate Predicate[T]) Contains(element T) bool {
+	return predicate.root().contains(element)
+}
+
+func (n *node) contains(element any) bool {
+	switch n.kind {
+	case kindAtom:
+		return n.atom.contains(element)
+	case kindNot:
+		return !n.operands[0].contains(element)
+	case kindAnd:
+		return !n.anyOperand(element, false)
+	case kindOr:
+		return n.anyOperand(element, true)
+	default:
+		return n.kind == kindTrue
+	}
+}
+
+// anyOperand returns true, if the containment of the element in any operand equals contained.
+func (n *node) anyOperand(element any, contained bool) bool {
+	for _, operand := range n.operands {
+		if operand.contains(element) == contained {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String formats this [Predicate] with as few parentheses as possible,
+// for example "age >= 18 AND NOT in(hasVoted)".
+// NOT binds stronger than AND, which binds stronger than OR.
+func (predicate Predicate[T]) String() string {
+	var builder strings.Builder
+
+	predicate.root().format(&builder)
+
+	return builder.String()
+}
+
+func (n *node) precedence() int {
+	switch n.kind {
+	case kindOr:
+		return 1
+	case kindAnd:
+		return 2
+	case kindNot:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func (n *node) format(builder *strings.Builder) {
+	switch n.kind {
+	case kindTrue:
+		builder.WriteString("TRUE")
+	case kindFalse:
+		builder.WriteString("FALSE")
+	case kindAtom:
+		builder.WriteString(n.atom.String())
+	case kindNot:
+		builder.WriteString("NOT ")
+		n.operands[0].formatOperand(builder, n.precedence())
+	default:
+		n.formatOperands(builder)
+	}
+}
+
+// formatOperands formats the operands of a conjunction or disjunction.
+func (n *node) formatOperands(builder *strings.Builder) {
+	separator := " AND "
+	if n.kind == kindOr {
+		separator = " OR "
+	}
+
+	for i, operand := range n.operands {
+		if i > 0 {
+			builder.WriteString(separator)
+		}
+
+		operand.formatOperand(builder, n.precedence()+1)
+	}
+}
+
+func (n *node) formatOperand(builder *strings.Builder, precedence int) {
+	if n.precedence() >= precedence {
+		n.format(builder)
+
+		return
+	}
+
+	builder.WriteByte('(')
+	n.format(builder)
+	builder.WriteByte(')')
+}
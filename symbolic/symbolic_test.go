@@ -0,0 +1,93 @@
+package symbolic_test
+
+import (
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/sets"
+	"github.com/frederik-jatzkowski/cantor/symbolic"
+)
+
+func TestPredicate_Container(t *testing.T) {
+	sets.RunTestsForContainer(t, func(elements ...byte) cantor.Container[byte] {
+		operands := make([]symbolic.Predicate[byte], len(elements))
+		for i, element := range elements {
+			operands[i] = symbolic.Eq(element)
+		}
+
+		return symbolic.Or(operands...)
+	})
+}
+
+func TestPredicate_Contains(t *testing.T) {
+	all := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14}
+	small := symbolic.Lt(10)
+	even := symbolic.In[int]("even", cantor.NewImplicitSet(func(i int) bool { return i%2 == 0 }))
+
+	tests := map[string]struct {
+		predicate symbolic.Predicate[int]
+		expected  []int
+	}{
+		"zero value": {predicate: symbolic.Predicate[int]{}, expected: all},
+		"true":       {predicate: symbolic.True[int](), expected: all},
+		"false":      {predicate: symbolic.False[int](), expected: []int{}},
+		"and":        {predicate: symbolic.And(small, even, symbolic.Gt(0)), expected: []int{2, 4, 6, 8}},
+		"empty and":  {predicate: symbolic.And[int](), expected: all},
+		"single and": {predicate: symbolic.And(even), expected: []int{0, 2, 4, 6, 8, 10, 12, 14}},
+		"or":         {predicate: symbolic.Or(symbolic.Eq(11), symbolic.Eq(13)), expected: []int{11, 13}},
+		"empty or":   {predicate: symbolic.Or[int](), expected: []int{}},
+		"not":        {predicate: symbolic.Not(symbolic.Or(small, even)), expected: []int{11, 13}},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 15; i++ {
+				expected := false
+
+				for _, element := range test.expected {
+					expected = expected || element == i
+				}
+
+				if test.predicate.Contains(i) != expected {
+					t.Errorf("expected Contains(%d) of %s to be %v", i, test.predicate, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestPredicate_String(t *testing.T) {
+	a := symbolic.In[int]("a", cantor.NewHashSet[int]())
+	b := symbolic.In[int]("b", cantor.NewHashSet[int]())
+	c := symbolic.In[int]("c", cantor.NewHashSet[int]())
+
+	tests := map[string]struct {
+		predicate symbolic.Predicate[int]
+		expected  string
+	}{
+		"zero value":        {predicate: symbolic.Predicate[int]{}, expected: "TRUE"},
+		"false":             {predicate: symbolic.False[int](), expected: "FALSE"},
+		"and":               {predicate: symbolic.And(a, symbolic.Not(b)), expected: "in(a) AND NOT in(b)"},
+		"flattened and":     {predicate: symbolic.And(symbolic.And(a, b), c), expected: "in(a) AND in(b) AND in(c)"},
+		"flattened or":      {predicate: symbolic.Or(a, symbolic.Or(b, c)), expected: "in(a) OR in(b) OR in(c)"},
+		"and in or":         {predicate: symbolic.Or(symbolic.And(a, b), c), expected: "in(a) AND in(b) OR in(c)"},
+		"or in and":         {predicate: symbolic.And(symbolic.Or(a, b), c), expected: "(in(a) OR in(b)) AND in(c)"},
+		"not of and":        {predicate: symbolic.Not(symbolic.And(a, b)), expected: "NOT (in(a) AND in(b))"},
+		"double negation":   {predicate: symbolic.Not(symbolic.Not(a)), expected: "NOT NOT in(a)"},
+		"negated true":      {predicate: symbolic.Not(symbolic.True[int]()), expected: "NOT TRUE"},
+		"comparison in and": {predicate: symbolic.And(symbolic.Ge(1), symbolic.Not(a)), expected: "x >= 1 AND NOT in(a)"},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			actual := test.predicate.String()
+			if actual != test.expected {
+				t.Errorf("expected %q but got %q", test.expected, actual)
+			}
+		})
+	}
+}
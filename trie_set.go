@@ -0,0 +1,486 @@
+package cantor
+
+import (
+	"sort"
+	"strings"
+)
+
+// [TrieSet] implements [Set] for strings using a radix tree, in which elements with a common prefix share nodes.
+// In addition to the methods of [Set], it answers prefix queries like [TrieSet.WithPrefix]
+// and [TrieSet.LongestPrefixOf] and iterates its elements in lexicographical byte order.
+type TrieSet struct {
+	root *trieNode
+}
+
+// trieNode is a node of a radix tree. The key of a node is the concatenation of the labels on the path to it.
+type trieNode struct {
+	// label is the part of the key between the parent and this node. It is only empty for the root.
+	label string
+	// children are sorted by their labels, which start with distinct bytes.
+	children []*trieNode
+	// terminal is true, if the key of this node is an element.
+	terminal bool
+	// size is the number of elements in the subtree of this node.
+	size int
+}
+
+// [NewTrieSet] returns an initialized [TrieSet] containing all provided elements.
+// The given elements are deduplicated.
+func NewTrieSet(elements ...string) *TrieSet {
+	set := &TrieSet{root: &trieNode{}}
+
+	for _, element := range elements {
+		set.Add(element)
+	}
+
+	return set
+}
+
+// child returns the index of the child, whose label starts with b, and the child.
+// If there is no such child, it returns the index, at which it would be inserted, and nil.
+func (node *trieNode) child(b byte) (int, *trieNode) {
+	i := sort.Search(len(node.children), func(i int) bool {
+		return node.children[i].label[0] >= b
+	})
+
+	if i < len(node.children) && node.children[i].label[0] == b {
+		return i, node.children[i]
+	}
+
+	return i, nil
+}
+
+// split inserts a node between this node and its i-th child, whose label has the first length bytes.
+func (node *trieNode) split(i, length int) *trieNode {
+	child := node.children[i]
+	middle := &trieNode{label: child.label[:length], children: []*trieNode{child}, size: child.size}
+	child.label = child.label[length:]
+	node.children[i] = middle
+
+	return middle
+}
+
+// compress merges a node with its only child, if the node is not an element itself.
+func (node *trieNode) compress() {
+	if node.label == "" || node.terminal || len(node.children) != 1 {
+		return
+	}
+
+	child := node.children[0]
+	node.label += child.label
+	node.terminal = child.terminal
+	node.children = child.children
+}
+
+// walk calls yield with the keys of all elements in the subtree of this node in lexicographical order,
+// until yield returns false. The key of this node is stored in buffer.
+func (node *trieNode) walk(buffer []byte, yield func(element string) (next bool)) (next bool) {
+	if node.terminal && !yield(string(buffer)) {
+		return false
+	}
+
+	for _, child := range node.children {
+		if !child.walk(append(buffer, child.label...), yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// find returns the highest node, whose key starts with prefix, and its key.
+// If no element starts with prefix, it returns nil.
+func (set *TrieSet) find(prefix string) (node *trieNode, key string) {
+	node = set.root
+	rest := prefix
+
+	for rest != "" {
+		_, child := node.child(rest[0])
+
+		switch {
+		case child == nil:
+			return nil, ""
+		case strings.HasPrefix(rest, child.label):
+			rest = rest[len(child.label):]
+		case strings.HasPrefix(child.label, rest):
+			return child, prefix + child.label[len(rest):]
+		default:
+			return nil, ""
+		}
+
+		node = child
+	}
+
+	return node, prefix
+}
+
+// Add adds element and returns true if this operation actually changed the [TrieSet].
+// If the element was already contained, this leaves the set unchanged and returns false.
+//
+// Data views derived from this set will reflect the change.
+//
+// The time complexity of this method is O(k), where k is the length of the element.
+func (set *TrieSet) Add(element string) (modified bool) {
+	if set.Contains(element) {
+		return false
+	}
+
+	node, rest := set.root, element
+
+	for {
+		node.size++
+
+		if rest == "" {
+			node.terminal = true
+
+			return true
+		}
+
+		i, child := node.child(rest[0])
+		if child == nil {
+			node.children = append(node.children, nil)
+			copy(node.children[i+1:], node.children[i:])
+			node.children[i] = &trieNode{label: rest, terminal: true, size: 1}
+
+			return true
+		}
+
+		common := commonPrefixLength(child.label, rest)
+		if common < len(child.label) {
+			child = node.split(i, common)
+		}
+
+		node, rest = child, rest[common:]
+	}
+}
+
+func commonPrefixLength(a, b string) (length int) {
+	for length < len(a) && length < len(b) && a[length] == b[length] {
+		length++
+	}
+
+	return length
+}
+
+// Remove removes element and returns true if this operation actually changed the [TrieSet].
+// If the element was not in the set, this leaves the set unchanged and returns false.
+//
+// Data views derived from this set will reflect the change.
+//
+// The time complexity of this method is O(k), where k is the length of the element.
+func (set *TrieSet) Remove(element string) (modified bool) {
+	if !set.Contains(element) {
+		return false
+	}
+
+	var parent *trieNode
+
+	node, rest, i := set.root, element, 0
+
+	for {
+		node.size--
+
+		if rest == "" {
+			break
+		}
+
+		parent = node
+		i, node = node.child(rest[0])
+		rest = rest[len(node.label):]
+	}
+
+	node.terminal = false
+
+	if node.size == 0 && parent != nil {
+		parent.children = append(parent.children[:i], parent.children[i+1:]...)
+		node = parent
+	}
+
+	node.compress()
+
+	return true
+}
+
+// AddAll adds all elements of the iterator and returns the number of elements, which were actually added.
+//
+// Data views derived from this set will reflect the change.
+//
+// The time complexity of this method is O(n*k), where n is the number of elements of the iterator
+// and k is their length.
+func (set *TrieSet) AddAll(iterator Iterator[string]) (modified int) {
+	before := set.Size()
+
+	iterator(func(element string) bool {
+		set.Add(element)
+
+		return true
+	})
+
+	return set.Size() - before
+}
+
+// RemoveAll removes all elements, which are contained in the other container,
+// and returns the number of elements, which were actually removed.
+//
+// Data views derived from this set will reflect the change.
+func (set *TrieSet) RemoveAll(other Container[string]) (modified int) {
+	return set.removeFrom(set.Intersect(other))
+}
+
+// RetainAll removes all elements, which are not contained in the other container,
+// and returns the number of elements, which were actually removed.
+//
+// Data views derived from this set will reflect the change.
+func (set *TrieSet) RetainAll(other Container[string]) (modified int) {
+	return set.removeFrom(set.Difference(other))
+}
+
+// removeFrom evaluates elements before removing them, since they are a view of this set.
+func (set *TrieSet) removeFrom(elements ReadableSet[string]) (modified int) {
+	for element := range NewHashSetFromIterator(elements.Elements()) {
+		set.Remove(element)
+		modified++
+	}
+
+	return modified
+}
+
+// Clear removes all elements and returns the number of elements, which were actually removed.
+//
+// Data views derived from this set will reflect the change.
+//
+// The time complexity of this method is O(1).
+func (set *TrieSet) Clear() (modified int) {
+	modified = set.Size()
+	set.root = &trieNode{}
+
+	return modified
+}
+
+// Contains returns whether the element is contained in this [TrieSet].
+//
+// The time complexity of this method is O(k), where k is the length of the element.
+func (set *TrieSet) Contains(element string) bool {
+	node, key := set.find(element)
+
+	return node != nil && node.terminal && len(key) == len(element)
+}
+
+// WithPrefix returns a [ReadableSet] with all elements of this [TrieSet], which start with prefix.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+// Its size is determined in O(k), where k is the length of the prefix.
+func (set *TrieSet) WithPrefix(prefix string) ReadableSet[string] {
+	return prefixView{set: set, prefix: prefix}
+}
+
+// LongestPrefixOf returns the longest element of this [TrieSet], which is a prefix of s,
+// and true, if there is such an element.
+//
+// The time complexity of this method is O(k), where k is the length of s.
+func (set *TrieSet) LongestPrefixOf(s string) (prefix string, ok bool) {
+	node, matched, longest := set.root, 0, -1
+
+	for {
+		if node.terminal {
+			longest = matched
+		}
+
+		if matched == len(s) {
+			break
+		}
+
+		_, child := node.child(s[matched])
+		if child == nil || !strings.HasPrefix(s[matched:], child.label) {
+			break
+		}
+
+		node, matched = child, matched+len(child.label)
+	}
+
+	if longest < 0 {
+		return "", false
+	}
+
+	return s[:longest], true
+}
+
+// HasAnyPrefix returns an [ImplicitSet] of all strings, which start with an element of this [TrieSet].
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *TrieSet) HasAnyPrefix() ImplicitSet[string] {
+	return NewImplicitSet(func(element string) bool {
+		_, ok := set.LongestPrefixOf(element)
+
+		return ok
+	})
+}
+
+// [HasPrefix] returns an [ImplicitSet] of all strings, which start with prefix.
+func HasPrefix(prefix string) ImplicitSet[string] {
+	return NewImplicitSet(func(element string) bool {
+		return strings.HasPrefix(element, prefix)
+	})
+}
+
+// Union returns a [ReadableSet] representing the set union of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *TrieSet) Union(other ReadableSet[string]) ReadableSet[string] {
+	return newUnion[string](set, other)
+}
+
+// Intersect returns a [ReadableSet] representing the set intersection of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *TrieSet) Intersect(other Container[string]) ReadableSet[string] {
+	return newIntersection[string](set, other)
+}
+
+// Complement returns an [ImplicitSet], representing all elements not contained in this set.
+// This might represent infinitely many elements.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *TrieSet) Complement() ImplicitSet[string] {
+	return NewImplicitSet(func(element string) bool {
+		return !set.Contains(element)
+	})
+}
+
+// Difference returns a [ReadableSet] with all elements of this [TrieSet],
+// which are not contained in the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *TrieSet) Difference(other Container[string]) ReadableSet[string] {
+	return set.Intersect(negation[string]{arg: other})
+}
+
+// SymmetricDifference returns a ReadableSet representing the set with all elements of this and the other set,
+// which are contained in exactly one of the two.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *TrieSet) SymmetricDifference(other ReadableSet[string]) ReadableSet[string] {
+	return set.Difference(other).Union(other.Difference(set))
+}
+
+// Equals returns true, if this [TrieSet] and the other [ReadableSet] represent exactly the same elements.
+func (set *TrieSet) Equals(other ReadableSet[string]) bool {
+	return set.SymmetricDifference(other).Size() == 0
+}
+
+// Subset returns true, if all elements of this [TrieSet] are contained in the other [Container].
+func (set *TrieSet) Subset(other Container[string]) bool {
+	return set.Difference(other).Size() == 0
+}
+
+// StrictSubset returns true, if all elements of this [TrieSet] are contained in the other [ReadableSet]
+// and the sets are not equal.
+func (set *TrieSet) StrictSubset(other ReadableSet[string]) bool {
+	return set.Difference(other).Size() == 0 && other.Difference(set).Size() > 0
+}
+
+// Elements returns an [Iterator] over the elements of this [TrieSet] in lexicographical byte order.
+// Iteration is stopped, if the yield function returns false.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (set *TrieSet) Elements() Iterator[string] {
+	return set.WithPrefix("").Elements()
+}
+
+// Size returns the number of unique elements contained in this [TrieSet].
+//
+// The time complexity of this method is O(1).
+func (set *TrieSet) Size() int {
+	return set.root.size
+}
+
+// SafeForConcurrentReads implements [ConcurrentReader] and returns true,
+// since a [TrieSet] can be read concurrently as long as it is not modified at the same time.
+func (set *TrieSet) SafeForConcurrentReads() bool {
+	return true
+}
+
+// MarshalJSON implements [json.Marshaler] for this [TrieSet].
+// The set is encoded as a JSON array of its elements in lexicographical byte order.
+func (set *TrieSet) MarshalJSON() ([]byte, error) {
+	return marshalJSON[string](set)
+}
+
+// String implements [fmt.Stringer] for this [TrieSet].
+func (set *TrieSet) String() string {
+	return toString[string](set)
+}
+
+// prefixView is the data view returned by [TrieSet.WithPrefix].
+type prefixView struct {
+	set    *TrieSet
+	prefix string
+}
+
+func (view prefixView) Contains(element string) bool {
+	return strings.HasPrefix(element, view.prefix) && view.set.Contains(element)
+}
+
+func (view prefixView) Union(other ReadableSet[string]) ReadableSet[string] {
+	return newUnion[string](view, other)
+}
+
+func (view prefixView) Intersect(other Container[string]) ReadableSet[string] {
+	return newIntersection[string](view, other)
+}
+
+func (view prefixView) Complement() ImplicitSet[string] {
+	return NewImplicitSet(func(element string) bool {
+		return !view.Contains(element)
+	})
+}
+
+func (view prefixView) Difference(other Container[string]) ReadableSet[string] {
+	return view.Intersect(negation[string]{arg: other})
+}
+
+func (view prefixView) SymmetricDifference(other ReadableSet[string]) ReadableSet[string] {
+	return view.Difference(other).Union(other.Difference(view))
+}
+
+func (view prefixView) Equals(other ReadableSet[string]) bool {
+	return view.SymmetricDifference(other).Size() == 0
+}
+
+func (view prefixView) Subset(other Container[string]) bool {
+	return view.Difference(other).Size() == 0
+}
+
+func (view prefixView) StrictSubset(other ReadableSet[string]) bool {
+	return view.Difference(other).Size() == 0 && other.Difference(view).Size() > 0
+}
+
+func (view prefixView) Elements() Iterator[string] {
+	return func(yield func(element string) (next bool)) {
+		node, key := view.set.find(view.prefix)
+		if node != nil {
+			node.walk([]byte(key), yield)
+		}
+	}
+}
+
+func (view prefixView) Size() int {
+	node, _ := view.set.find(view.prefix)
+	if node == nil {
+		return 0
+	}
+
+	return node.size
+}
+
+func (view prefixView) SafeForConcurrentReads() bool {
+	return true
+}
+
+func (view prefixView) MarshalJSON() ([]byte, error) {
+	return marshalJSON[string](view)
+}
+
+func (view prefixView) String() string {
+	return toString[string](view)
+}
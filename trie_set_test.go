@@ -0,0 +1,372 @@
+package cantor_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/sets"
+)
+
+// decimalTrie adapts a [cantor.TrieSet] to the byte based test suites.
+// The decimal representations of bytes share many prefixes like "1", "12" and "123".
+type decimalTrie struct {
+	trie *cantor.TrieSet
+}
+
+func decimal(element byte) string {
+	return strconv.Itoa(int(element))
+}
+
+func fromDecimal(key string) byte {
+	element, _ := strconv.Atoi(key)
+
+	return byte(element)
+}
+
+func decimals(container cantor.Container[byte]) cantor.ImplicitSet[string] {
+	return cantor.NewImplicitSet(func(key string) bool {
+		return container.Contains(fromDecimal(key))
+	})
+}
+
+func (set decimalTrie) Add(element byte) bool {
+	return set.trie.Add(decimal(element))
+}
+
+func (set decimalTrie) Remove(element byte) bool {
+	return set.trie.Remove(decimal(element))
+}
+
+func (set decimalTrie) AddAll(iterator cantor.Iterator[byte]) int {
+	return set.trie.AddAll(func(yield func(key string) bool) {
+		iterator(func(element byte) bool {
+			return yield(decimal(element))
+		})
+	})
+}
+
+func (set decimalTrie) RemoveAll(other cantor.Container[byte]) int {
+	return set.trie.RemoveAll(decimals(other))
+}
+
+func (set decimalTrie) RetainAll(other cantor.Container[byte]) int {
+	return set.trie.RetainAll(decimals(other))
+}
+
+func (set decimalTrie) Clear() int {
+	return set.trie.Clear()
+}
+
+func (set decimalTrie) Contains(element byte) bool {
+	return set.trie.Contains(decimal(element))
+}
+
+func (set decimalTrie) Elements() cantor.Iterator[byte] {
+	return func(yield func(element byte) bool) {
+		set.trie.Elements()(func(key string) bool {
+			return yield(fromDecimal(key))
+		})
+	}
+}
+
+func (set decimalTrie) Size() int {
+	return set.trie.Size()
+}
+
+func (set decimalTrie) Union(other cantor.ReadableSet[byte]) cantor.ReadableSet[byte] {
+	return cantor.NewUnion[byte](set, other)
+}
+
+func (set decimalTrie) Intersect(other cantor.Container[byte]) cantor.ReadableSet[byte] {
+	return cantor.NewIntersection[byte](set, other)
+}
+
+func (set decimalTrie) Complement() cantor.ImplicitSet[byte] {
+	return cantor.NewImplicitSet(func(element byte) bool {
+		return !set.Contains(element)
+	})
+}
+
+func (set decimalTrie) Difference(other cantor.Container[byte]) cantor.ReadableSet[byte] {
+	return cantor.NewDifference[byte](set, other)
+}
+
+func (set decimalTrie) SymmetricDifference(other cantor.ReadableSet[byte]) cantor.ReadableSet[byte] {
+	return set.Difference(other).Union(other.Difference(set))
+}
+
+func (set decimalTrie) Equals(other cantor.ReadableSet[byte]) bool {
+	return set.SymmetricDifference(other).Size() == 0
+}
+
+func (set decimalTrie) Subset(other cantor.Container[byte]) bool {
+	return set.Difference(other).Size() == 0
+}
+
+func (set decimalTrie) StrictSubset(other cantor.ReadableSet[byte]) bool {
+	return set.Subset(other) && !set.Equals(other)
+}
+
+func (set decimalTrie) String() string {
+	return set.trie.String()
+}
+
+func (set decimalTrie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cantor.NewHashSetFromIterator(set.Elements()))
+}
+
+func TestTrieSet_Set(t *testing.T) {
+	sets.RunTestsForSet(t, func(elements ...byte) cantor.Set[byte] {
+		set := decimalTrie{trie: cantor.NewTrieSet()}
+		for _, element := range elements {
+			set.Add(element)
+		}
+
+		return set
+	})
+}
+
+func TestTrieSet(t *testing.T) {
+	elements := []string{"", "a", "ab", "abc", "abd", "b", "bcd", "bce", "xyz"}
+	set := cantor.NewTrieSet("bce", "abd", "", "xyz", "abc", "bcd", "b", "ab", "a", "a")
+
+	t.Run("order", func(t *testing.T) {
+		data, err := json.Marshal(set)
+		if err != nil || string(data) != `["","a","ab","abc","abd","b","bcd","bce","xyz"]` {
+			t.Errorf("unexpected elements: %s, %v", data, err)
+		}
+
+		if set.String() != `{"", a, ab, abc, abd, b, bcd, bce, xyz}` {
+			t.Errorf("unexpected elements: %v", set)
+		}
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		for _, element := range elements {
+			if !set.Contains(element) {
+				t.Errorf("expected %q to be contained", element)
+			}
+		}
+
+		for _, element := range []string{"aa", "abcd", "bc", "bcf", "c", "x", "xyzz"} {
+			if set.Contains(element) {
+				t.Errorf("expected %q not to be contained", element)
+			}
+		}
+
+		if set.Size() != len(elements) || !set.SafeForConcurrentReads() {
+			t.Errorf("unexpected size %d", set.Size())
+		}
+	})
+
+	t.Run("LongestPrefixOf", func(t *testing.T) {
+		for _, test := range []struct {
+			set      *cantor.TrieSet
+			s        string
+			expected string
+			ok       bool
+		}{
+			{set: set, s: "abcdef", expected: "abc", ok: true},
+			{set: set, s: "abx", expected: "ab", ok: true},
+			{set: set, s: "bc", expected: "b", ok: true},
+			{set: set, s: "b", expected: "b", ok: true},
+			{set: set, s: "z", expected: "", ok: true},
+			{set: cantor.NewTrieSet("/api/", "/api/v1/"), s: "/api/v1/users", expected: "/api/v1/", ok: true},
+			{set: cantor.NewTrieSet("/api/", "/api/v1/"), s: "/api/v2/users", expected: "/api/", ok: true},
+			{set: cantor.NewTrieSet("/api/", "/api/v1/"), s: "/static/", expected: "", ok: false},
+			{set: cantor.NewTrieSet("/api/v1/"), s: "/api/", expected: "", ok: false},
+		} {
+			prefix, ok := test.set.LongestPrefixOf(test.s)
+			if prefix != test.expected || ok != test.ok {
+				t.Errorf("expected %q and %v for %q but got %q and %v", test.expected, test.ok, test.s, prefix, ok)
+			}
+		}
+	})
+
+	t.Run("views", func(t *testing.T) {
+		other := cantor.NewHashSet("a", "c")
+
+		switch {
+		case !set.Union(other).Equals(cantor.NewHashSet(append(elements, "c")...)):
+			t.Errorf("unexpected union")
+		case !set.Intersect(other).Equals(cantor.NewHashSet("a")):
+			t.Errorf("unexpected intersection")
+		case !set.Difference(other).Equals(cantor.NewHashSet("", "ab", "abc", "abd", "b", "bcd", "bce", "xyz")):
+			t.Errorf("unexpected difference")
+		case !set.SymmetricDifference(other).Equals(cantor.NewHashSet("", "ab", "abc", "abd", "b", "bcd", "bce", "xyz", "c")):
+			t.Errorf("unexpected symmetric difference")
+		case set.Complement().Contains("a") || !set.Complement().Contains("c"):
+			t.Errorf("unexpected complement")
+		case !set.Subset(cantor.NewHashSet(elements...)) || set.StrictSubset(cantor.NewHashSet(elements...)):
+			t.Errorf("unexpected subset relations")
+		case !set.StrictSubset(cantor.NewHashSet(append(elements, "c")...)) || set.Equals(other):
+			t.Errorf("unexpected subset relations")
+		}
+	})
+}
+
+func TestTrieSet_WithPrefix(t *testing.T) {
+	set := cantor.NewTrieSet("", "a", "ab", "abc", "abd", "b", "bcd", "bce", "xyz")
+
+	for _, test := range []struct {
+		prefix   string
+		expected string
+	}{
+		{prefix: "", expected: `["","a","ab","abc","abd","b","bcd","bce","xyz"]`},
+		{prefix: "a", expected: `["a","ab","abc","abd"]`},
+		{prefix: "ab", expected: `["ab","abc","abd"]`},
+		{prefix: "abc", expected: `["abc"]`},
+		{prefix: "bc", expected: `["bcd","bce"]`},
+		{prefix: "x", expected: `["xyz"]`},
+		{prefix: "xy", expected: `["xyz"]`},
+		{prefix: "xz", expected: `[]`},
+		{prefix: "abcd", expected: `[]`},
+		{prefix: "c", expected: `[]`},
+	} {
+		test := test
+
+		t.Run(test.prefix, func(t *testing.T) {
+			view := set.WithPrefix(test.prefix)
+
+			data, err := json.Marshal(view)
+			if err != nil || string(data) != test.expected {
+				t.Errorf("expected %s but got %s, %v", test.expected, data, err)
+			}
+
+			var expected []string
+			if err = json.Unmarshal([]byte(test.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+
+			if view.Size() != len(expected) || !view.Equals(cantor.NewHashSet(expected...)) {
+				t.Errorf("expected %d elements but got %d", len(expected), view.Size())
+			}
+		})
+	}
+
+	t.Run("views", func(t *testing.T) {
+		view := set.WithPrefix("ab")
+		other := cantor.NewHashSet("abc", "b")
+
+		switch {
+		case view.String() != "{ab, abc, abd}":
+			t.Errorf("unexpected string %v", view)
+		case !view.Contains("abd") || view.Contains("a") || view.Contains("abx"):
+			t.Errorf("unexpected containment")
+		case !view.Union(other).Equals(cantor.NewHashSet("ab", "abc", "abd", "b")):
+			t.Errorf("unexpected union")
+		case !view.Intersect(other).Equals(cantor.NewHashSet("abc")):
+			t.Errorf("unexpected intersection")
+		case !view.SymmetricDifference(other).Equals(cantor.NewHashSet("ab", "abd", "b")):
+			t.Errorf("unexpected symmetric difference")
+		case view.Complement().Contains("ab") || !view.Complement().Contains("a"):
+			t.Errorf("unexpected complement")
+		case !view.Subset(set) || !view.StrictSubset(set) || view.StrictSubset(view):
+			t.Errorf("unexpected subset relations")
+		case !view.(cantor.ConcurrentReader).SafeForConcurrentReads():
+			t.Errorf("expected the view to be safe for concurrent reads")
+		}
+	})
+
+	t.Run("stopped iteration", func(t *testing.T) {
+		var visited []string
+
+		set.WithPrefix("ab").Elements()(func(element string) bool {
+			visited = append(visited, element)
+
+			return len(visited) < 2
+		})
+
+		if len(visited) != 2 {
+			t.Errorf("expected iteration to stop after 2 elements but got %v", visited)
+		}
+	})
+
+	t.Run("data view", func(t *testing.T) {
+		set := cantor.NewTrieSet("/api/v1")
+		view := set.WithPrefix("/api/")
+
+		set.Add("/api/v2")
+		set.Add("/static/")
+
+		if !view.Equals(cantor.NewHashSet("/api/v1", "/api/v2")) {
+			t.Errorf("expected the view to reflect changes but got %v", view)
+		}
+
+		set.Clear()
+
+		if view.Size() != 0 {
+			t.Errorf("expected the view to be empty but got %v", view)
+		}
+	})
+}
+
+func TestTrieSet_Remove(t *testing.T) {
+	set := cantor.NewTrieSet("", "a", "ab", "abc", "abd", "b", "bcd", "bce", "xyz")
+
+	for _, test := range []struct {
+		removed  string
+		expected string
+	}{
+		{removed: "ab", expected: `["","a","abc","abd","b","bcd","bce","xyz"]`},
+		{removed: "abc", expected: `["","a","abd","b","bcd","bce","xyz"]`},
+		{removed: "", expected: `["a","abd","b","bcd","bce","xyz"]`},
+		{removed: "bce", expected: `["a","abd","b","bcd","xyz"]`},
+		{removed: "b", expected: `["a","abd","bcd","xyz"]`},
+		{removed: "a", expected: `["abd","bcd","xyz"]`},
+		{removed: "xyz", expected: `["abd","bcd"]`},
+		{removed: "abd", expected: `["bcd"]`},
+		{removed: "bcd", expected: `[]`},
+	} {
+		if !set.Remove(test.removed) || set.Remove(test.removed) {
+			t.Errorf("expected %q to be removed exactly once", test.removed)
+		}
+
+		data, err := json.Marshal(set)
+		if err != nil || string(data) != test.expected {
+			t.Errorf("expected %s after removing %q but got %s, %v", test.expected, test.removed, data, err)
+		}
+
+		// adding the element again must restore the structure, which is compressed by Remove
+		if !set.Add(test.removed) || !set.Remove(test.removed) {
+			t.Errorf("expected %q to be added and removed again", test.removed)
+		}
+	}
+
+	if set.Size() != 0 || set.WithPrefix("").Size() != 0 {
+		t.Errorf("expected the set to be empty but got %v", set)
+	}
+}
+
+func TestHasPrefix(t *testing.T) {
+	routes := cantor.NewTrieSet("/api/", "/static/")
+	matched := routes.HasAnyPrefix().Intersect(cantor.HasPrefix("/api/").Complement())
+	paths := cantor.NewHashSet("/api/users", "/static/logo.png", "/index.html", "/static")
+
+	if !paths.Intersect(matched).Equals(cantor.NewHashSet("/static/logo.png")) {
+		t.Errorf("unexpected matched paths: %v", paths.Intersect(matched))
+	}
+
+	routes.Add("/")
+
+	if !paths.Intersect(matched).Equals(cantor.NewHashSet("/static/logo.png", "/index.html", "/static")) {
+		t.Errorf("expected the prefix predicate to reflect changes but got %v", paths.Intersect(matched))
+	}
+}
+
+func ExampleTrieSet() {
+	routes := cantor.NewTrieSet("/api/", "/api/v1/", "/static/")
+
+	fmt.Println(routes.LongestPrefixOf("/api/v1/users"))
+	fmt.Println(routes.WithPrefix("/api/"))
+
+	paths := cantor.NewHashSet("/api/v2/users", "/index.html")
+	fmt.Println(paths.Intersect(routes.HasAnyPrefix()))
+	// Output:
+	// /api/v1/ true
+	// {/api/, /api/v1/}
+	// {/api/v2/users}
+}
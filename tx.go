@@ -0,0 +1,314 @@
+package cantor
+
+import "errors"
+
+// [ErrTxDone] is returned when committing or rolling back a [Tx], which was already committed or rolled back.
+var ErrTxDone = errors.New("cantor: transaction has already been committed or rolled back")
+
+// [Tx] is a transaction, which collects changes of a [Set] and applies them at once.
+// Data views derived from the underlying set do not observe the changes before [Tx.Commit].
+// The transaction itself implements [Set] and reflects the underlying set including its changes,
+// so that views derived from the transaction observe them immediately.
+//
+// The underlying set must not be modified by others, while the transaction is open.
+// A [Tx] is not safe for concurrent use.
+type Tx[T comparable] struct {
+	set     Set[T]
+	added   HashSet[T]
+	removed HashSet[T]
+	done    bool
+}
+
+// [NewTx] starts a [Tx] for set.
+func NewTx[T comparable](set Set[T]) *Tx[T] {
+	return &Tx[T]{
+		set:     set,
+		added:   NewHashSet[T](),
+		removed: NewHashSet[T](),
+	}
+}
+
+// Commit applies all changes of the transaction to the underlying set and returns them as a [SetDiff],
+// which can be published as a single change event. Added and Removed contain the elements, which actually changed,
+// while Unchanged is a data view of the remaining elements of the set.
+// Afterwards, the transaction reflects the underlying set without changes.
+//
+// If the underlying set panics, all changes applied so far are undone in reverse order before the panic is propagated.
+// The transaction then remains open with all its changes, so it can be corrected and committed again or rolled back.
+// It returns [ErrTxDone], if the transaction was already committed or rolled back.
+func (tx *Tx[T]) Commit() (SetDiff[T], error) {
+	if tx.done {
+		return SetDiff[T]{}, ErrTxDone
+	}
+
+	added, removed := NewHashSet[T](), NewHashSet[T]()
+	log := &undoLog[T]{set: tx.set}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			log.revert()
+			panic(recovered)
+		}
+	}()
+
+	for element := range tx.removed {
+		if log.remove(element) {
+			removed.Add(element)
+		}
+	}
+
+	for element := range tx.added {
+		if log.add(element) {
+			added.Add(element)
+		}
+	}
+
+	// the transaction only finishes, once all changes were applied
+	tx.done = true
+	tx.added, tx.removed = NewHashSet[T](), NewHashSet[T]()
+
+	return SetDiff[T]{Added: added, Removed: removed, Unchanged: tx.set.Difference(added)}, nil
+}
+
+// Rollback discards all changes of the transaction. The underlying set is left unchanged.
+// Afterwards, the transaction reflects the underlying set without changes.
+// It returns [ErrTxDone], if the transaction was already committed or rolled back.
+func (tx *Tx[T]) Rollback() error {
+	if tx.done {
+		return ErrTxDone
+	}
+
+	tx.done = true
+	tx.added, tx.removed = NewHashSet[T](), NewHashSet[T]()
+
+	return nil
+}
+
+// Add adds element to the transaction and returns true if this actually changed the transaction.
+// It panics, if the transaction was already committed or rolled back.
+//
+// The time complexity of this method is O(1) plus the time complexity of Contains of the underlying set.
+func (tx *Tx[T]) Add(element T) (modified bool) {
+	tx.assertOpen()
+
+	if tx.removed.Remove(element) {
+		return true
+	}
+
+	if tx.set.Contains(element) {
+		return false
+	}
+
+	return tx.added.Add(element)
+}
+
+// Remove removes element from the transaction and returns true if this actually changed the transaction.
+// It panics, if the transaction was already committed or rolled back.
+//
+// The time complexity of this method is O(1) plus the time complexity of Contains of the underlying set.
+func (tx *Tx[T]) Remove(element T) (modified bool) {
+	tx.assertOpen()
+
+	if tx.added.Remove(element) {
+		return true
+	}
+
+	if !tx.set.Contains(element) {
+		return false
+	}
+
+	return tx.removed.Add(element)
+}
+
+// AddAll adds all elements of the iterator to the transaction and returns the number of elements,
+// which were actually added. It panics, if the transaction was already committed or rolled back.
+func (tx *Tx[T]) AddAll(iterator Iterator[T]) (modified int) {
+	tx.assertOpen()
+
+	iterator(func(element T) bool {
+		if tx.Add(element) {
+			modified++
+		}
+
+		return true
+	})
+
+	return modified
+}
+
+// RemoveAll removes all elements, which are contained in the other container, from the transaction
+// and returns the number of elements, which were actually removed.
+// It panics, if the transaction was already committed or rolled back.
+func (tx *Tx[T]) RemoveAll(other Container[T]) (modified int) {
+	return tx.removeFrom(tx.Intersect(other))
+}
+
+// RetainAll removes all elements, which are not contained in the other container, from the transaction
+// and returns the number of elements, which were actually removed.
+// It panics, if the transaction was already committed or rolled back.
+func (tx *Tx[T]) RetainAll(other Container[T]) (modified int) {
+	return tx.removeFrom(tx.Difference(other))
+}
+
+// Clear removes all elements from the transaction and returns the number of elements, which were actually removed.
+// The underlying set is only cleared on [Tx.Commit].
+// It panics, if the transaction was already committed or rolled back.
+func (tx *Tx[T]) Clear() (modified int) {
+	return tx.removeFrom(tx)
+}
+
+// removeFrom evaluates elements before removing them, since they might be a view of the transaction.
+func (tx *Tx[T]) removeFrom(elements ReadableSet[T]) (modified int) {
+	tx.assertOpen()
+
+	for element := range NewHashSetFromIterator(elements.Elements()) {
+		tx.Remove(element)
+		modified++
+	}
+
+	return modified
+}
+
+func (tx *Tx[T]) assertOpen() {
+	if tx.done {
+		panic(ErrTxDone)
+	}
+}
+
+// Contains returns whether the element is contained in the underlying set including the changes of the transaction.
+func (tx *Tx[T]) Contains(element T) bool {
+	return tx.added.Contains(element) || (!tx.removed.Contains(element) && tx.set.Contains(element))
+}
+
+// Union returns a [ReadableSet] representing the set union of this transaction and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (tx *Tx[T]) Union(other ReadableSet[T]) ReadableSet[T] {
+	return newUnion[T](tx, other)
+}
+
+// Intersect returns a [ReadableSet] representing the set intersection of this transaction and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (tx *Tx[T]) Intersect(other Container[T]) ReadableSet[T] {
+	return newIntersection[T](tx, other)
+}
+
+// Complement returns an [ImplicitSet], representing all elements not contained in this transaction.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (tx *Tx[T]) Complement() ImplicitSet[T] {
+	return derivedImplicitSet[T](func(element T) bool {
+		return !tx.Contains(element)
+	}, tx)
+}
+
+// Difference returns a [ReadableSet] with all elements of this transaction, which are not contained in the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (tx *Tx[T]) Difference(other Container[T]) ReadableSet[T] {
+	return tx.Intersect(negation[T]{arg: other})
+}
+
+// SymmetricDifference returns a [ReadableSet] representing the set with all elements of this transaction
+// and the other set, which are contained in exactly one of the two.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (tx *Tx[T]) SymmetricDifference(other ReadableSet[T]) ReadableSet[T] {
+	return tx.Difference(other).Union(other.Difference(tx))
+}
+
+// Equals returns true, if this transaction and the other [ReadableSet] represent exactly the same elements.
+func (tx *Tx[T]) Equals(other ReadableSet[T]) bool {
+	return tx.SymmetricDifference(other).Size() == 0
+}
+
+// Subset returns true, if all elements of this transaction are contained in the other [Container].
+func (tx *Tx[T]) Subset(other Container[T]) bool {
+	return tx.Difference(other).Size() == 0
+}
+
+// StrictSubset returns true, if all elements of this transaction are contained in the other [ReadableSet]
+// and the sets are not equal.
+func (tx *Tx[T]) StrictSubset(other ReadableSet[T]) bool {
+	return tx.Difference(other).Size() == 0 && other.Difference(tx).Size() > 0
+}
+
+// Elements returns an [Iterator] over the elements of the underlying set including the changes of the transaction.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (tx *Tx[T]) Elements() Iterator[T] {
+	return func(yield func(element T) (next bool)) {
+		next := true
+
+		tx.set.Elements()(func(element T) bool {
+			if !tx.removed.Contains(element) {
+				next = yield(element)
+			}
+
+			return next
+		})
+
+		if next {
+			tx.added.Elements()(yield)
+		}
+	}
+}
+
+// Size returns the number of elements of the underlying set including the changes of the transaction.
+func (tx *Tx[T]) Size() int {
+	return tx.set.Size() + len(tx.added) - len(tx.removed)
+}
+
+// MarshalJSON implements [json.Marshaler] for this transaction.
+// The transaction is encoded as a JSON array of its elements in no particular order.
+func (tx *Tx[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](tx)
+}
+
+// String implements [fmt.Stringer] for this transaction.
+func (tx *Tx[T]) String() string {
+	return toString[T](tx)
+}
+
+// undoLog records the changes applied to a set, so that they can be reverted.
+type undoLog[T comparable] struct {
+	set     Set[T]
+	entries []undoEntry[T]
+}
+
+type undoEntry[T comparable] struct {
+	element T
+	added   bool
+}
+
+func (log *undoLog[T]) add(element T) (modified bool) {
+	modified = log.set.Add(element)
+	if modified {
+		log.entries = append(log.entries, undoEntry[T]{element: element, added: true})
+	}
+
+	return modified
+}
+
+func (log *undoLog[T]) remove(element T) (modified bool) {
+	modified = log.set.Remove(element)
+	if modified {
+		log.entries = append(log.entries, undoEntry[T]{element: element, added: false})
+	}
+
+	return modified
+}
+
+// revert undoes all recorded changes in reverse order.
+func (log *undoLog[T]) revert() {
+	for i := len(log.entries) - 1; i >= 0; i-- {
+		if log.entries[i].added {
+			log.set.Remove(log.entries[i].element)
+		} else {
+			log.set.Add(log.entries[i].element)
+		}
+	}
+
+	log.entries = nil
+}
@@ -0,0 +1,186 @@
+package cantor_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/sets"
+)
+
+func TestTx_Set(t *testing.T) {
+	sets.RunTestsForSet(t, func(elements ...byte) cantor.Set[byte] {
+		// half of the elements are added by the transaction and 0 is removed by it
+		set := cantor.NewHashSet[byte](0)
+		for i, element := range elements {
+			if i%2 == 0 {
+				set.Add(element)
+			}
+		}
+
+		tx := cantor.NewTx[byte](set)
+		tx.Remove(0)
+
+		for _, element := range elements {
+			tx.Add(element)
+		}
+
+		return tx
+	})
+}
+
+func TestTx_Complement(t *testing.T) {
+	tx := cantor.NewTx[int](cantor.NewHashSet(1))
+
+	// a transaction can not be read concurrently, so neither can its complement
+	_, err := cantor.EvaluateParallel(cantor.NewHashSet(1, 2).Intersect(tx.Complement()), 2)
+	if !errors.Is(err, cantor.ErrNotConcurrencySafe) {
+		t.Errorf("expected ErrNotConcurrencySafe but got %v", err)
+	}
+}
+
+func TestTx_Commit(t *testing.T) {
+	set := cantor.NewHashSet(1, 2, 3)
+	view := set.Difference(cantor.NewHashSet(3))
+
+	tx := cantor.NewTx[int](set)
+
+	switch {
+	case !tx.Add(4), tx.Add(4), tx.Add(1):
+		t.Errorf("unexpected results of Add")
+	case !tx.Remove(1), tx.Remove(1), tx.Remove(5):
+		t.Errorf("unexpected results of Remove")
+	case !tx.Add(5), !tx.Remove(5), !tx.Remove(2), !tx.Add(2):
+		t.Errorf("expected changes of the transaction to be revertable")
+	case !view.Equals(cantor.NewHashSet(1, 2)):
+		t.Errorf("expected views of the set not to observe the transaction but got %v", view)
+	case !tx.Equals(cantor.NewHashSet(2, 3, 4)) || tx.Size() != 3:
+		t.Errorf("expected the transaction to reflect its changes but got %v", tx)
+	}
+
+	diff, err := tx.Commit()
+	if err != nil || diff.String() != "- 1\n+ 4" {
+		t.Errorf("unexpected diff %q (%v)", diff, err)
+	}
+
+	switch {
+	case !set.Equals(cantor.NewHashSet(2, 3, 4)):
+		t.Errorf("expected the changes to be applied but got %v", set)
+	case !diff.Unchanged.Equals(cantor.NewHashSet(2, 3)):
+		t.Errorf("unexpected unchanged elements %v", diff.Unchanged)
+	case !tx.Equals(set):
+		t.Errorf("expected the transaction to reflect the set after commit but got %v", tx)
+	}
+
+	_, err = tx.Commit()
+	if !errors.Is(err, cantor.ErrTxDone) {
+		t.Errorf("expected %v but got %v", cantor.ErrTxDone, err)
+	}
+
+	err = tx.Rollback()
+	if !errors.Is(err, cantor.ErrTxDone) {
+		t.Errorf("expected %v but got %v", cantor.ErrTxDone, err)
+	}
+}
+
+func TestTx_Rollback(t *testing.T) {
+	set := cantor.NewHashSet(1, 2)
+	tx := cantor.NewTx[int](set)
+
+	tx.Add(3)
+	tx.Remove(1)
+
+	err := tx.Rollback()
+	if err != nil || !set.Equals(cantor.NewHashSet(1, 2)) || !tx.Equals(set) {
+		t.Errorf("expected the set to be unchanged but got %v and %v (%v)", set, tx, err)
+	}
+
+	for name, modify := range map[string]func(){
+		"Add":    func() { tx.Add(4) },
+		"Remove": func() { tx.Remove(2) },
+	} {
+		func() {
+			defer func() {
+				if recovered := recover(); recovered != cantor.ErrTxDone {
+					t.Errorf("%s: expected a panic with %v but got %v", name, cantor.ErrTxDone, recovered)
+				}
+			}()
+
+			modify()
+		}()
+	}
+}
+
+// failingSet panics when adding the element 42.
+type failingSet struct {
+	cantor.HashSet[int]
+}
+
+func (set failingSet) Add(element int) bool {
+	if element == 42 {
+		panic("unsupported element")
+	}
+
+	return set.HashSet.Add(element)
+}
+
+func TestTx_Commit_panic(t *testing.T) {
+	set := failingSet{HashSet: cantor.NewHashSet(1, 2, 3)}
+
+	tx := cantor.NewTx[int](set)
+	for i := 4; i < 100; i++ {
+		tx.Add(i)
+	}
+
+	tx.Remove(1)
+	tx.Remove(2)
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected the panic to be propagated")
+			}
+		}()
+
+		_, _ = tx.Commit()
+	}()
+
+	if !set.Equals(cantor.NewHashSet(1, 2, 3)) {
+		t.Errorf("expected all changes to be undone but got %v", set)
+	}
+
+	// the transaction keeps its changes and can be committed without the failing element
+	if !tx.Contains(4) || tx.Contains(1) || !tx.Contains(42) {
+		t.Errorf("expected the transaction to keep its changes but got %v", tx)
+	}
+
+	tx.Remove(42)
+
+	diff, err := tx.Commit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff.Added.Size() != 95 || diff.Removed.Size() != 2 || set.Size() != 96 {
+		t.Errorf("expected the remaining changes to be applied but got %v", diff)
+	}
+}
+
+func ExampleTx() {
+	permissions := cantor.NewHashSet("read", "write")
+	tx := cantor.NewTx[string](permissions)
+
+	tx.Add("admin")
+	tx.Remove("write")
+	fmt.Println(permissions.Contains("admin"), tx.Contains("admin"))
+
+	diff, _ := tx.Commit()
+	fmt.Println(permissions.Contains("admin"))
+	fmt.Println(diff)
+	// Output:
+	// false true
+	// true
+	// - write
+	// + admin
+}
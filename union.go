@@ -4,6 +4,14 @@ type union[T comparable] struct {
 	args []ReadableSet[T]
 }
 
+// [NewUnion] returns a [ReadableSet] representing the set union of all arguments.
+// It can be used to implement [ReadableSet] outside of this package.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func NewUnion[T comparable](args ...ReadableSet[T]) ReadableSet[T] {
+	return newUnion(args...)
+}
+
 func newUnion[T comparable](args ...ReadableSet[T]) ReadableSet[T] {
 	return union[T]{
 		args: args,
@@ -29,15 +37,13 @@ func (set union[T]) Intersect(other Container[T]) ReadableSet[T] {
 }
 
 func (set union[T]) Complement() ImplicitSet[T] {
-	return NewImplicitSet(func(element T) bool {
+	return derivedImplicitSet[T](func(element T) bool {
 		return !set.Contains(element)
-	})
+	}, set)
 }
 
 func (set union[T]) Difference(other Container[T]) ReadableSet[T] {
-	return set.Intersect(NewImplicitSet[T](func(element T) bool {
-		return !other.Contains(element)
-	}))
+	return set.Intersect(negation[T]{arg: other})
 }
 
 func (set union[T]) SymmetricDifference(other ReadableSet[T]) ReadableSet[T] {
@@ -83,3 +89,17 @@ func (set union[T]) String() string {
 func (set union[T]) Size() int {
 	return count(set.Elements())
 }
+
+func (set union[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](set)
+}
+
+func (set union[T]) SafeForConcurrentReads() bool {
+	for _, arg := range set.args {
+		if !safeForConcurrentReads[T](arg) {
+			return false
+		}
+	}
+
+	return true
+}
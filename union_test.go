@@ -15,3 +15,12 @@ func Test_union_ReadableSet(t *testing.T) {
 		return a.Union(b)
 	})
 }
+
+func TestNewUnion_ReadableSet(t *testing.T) {
+	sets.RunTestsForReadableSet(t, func(elements ...byte) cantor.ReadableSet[byte] {
+		a := cantor.NewHashSet(elements[:len(elements)/2]...)
+		b := cantor.NewHashSet(elements[len(elements)/2:]...)
+
+		return cantor.NewUnion[byte](a, b)
+	})
+}
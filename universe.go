@@ -0,0 +1,109 @@
+package cantor
+
+// [Universe] is a finite and immutable [ReadableSet], which acts as the domain of all its subsets.
+// Within a [Universe], complements can be enumerated and counted. This is useful for enum-like types,
+// where all possible values are known.
+//
+// [Universe] implements [ReadableSet] and [Domain].
+type Universe[T comparable] struct {
+	elements HashSet[T]
+}
+
+// [NewUniverse] returns a [Universe] consisting of all provided elements.
+// The given elements are deduplicated.
+func NewUniverse[T comparable](elements ...T) Universe[T] {
+	return Universe[T]{elements: NewHashSet(elements...)}
+}
+
+// ComplementOf returns a [ReadableSet] with all elements of this [Universe], which are not contained in set.
+// Unlike the result of Complement, the result can be enumerated and counted.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (universe Universe[T]) ComplementOf(set Container[T]) ReadableSet[T] {
+	return universe.Difference(set)
+}
+
+// Contains returns whether the element is contained in this [Universe].
+//
+// The time complexity of this method is O(1).
+func (universe Universe[T]) Contains(element T) bool {
+	return universe.elements.Contains(element)
+}
+
+// Union returns a [ReadableSet] representing the set union of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (universe Universe[T]) Union(other ReadableSet[T]) ReadableSet[T] {
+	return newUnion[T](universe, other)
+}
+
+// Intersect returns a [ReadableSet] representing the set intersection of this set and the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (universe Universe[T]) Intersect(other Container[T]) ReadableSet[T] {
+	return newIntersection[T](universe, other)
+}
+
+// Complement returns an [ImplicitSet], representing all elements outside of this [Universe].
+// Use ComplementOf for complements within this [Universe].
+func (universe Universe[T]) Complement() ImplicitSet[T] {
+	return universe.elements.Complement()
+}
+
+// Difference returns a [ReadableSet] with all elements of this [Universe],
+// which are not contained in the argument.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (universe Universe[T]) Difference(other Container[T]) ReadableSet[T] {
+	return universe.Intersect(negation[T]{arg: other})
+}
+
+// SymmetricDifference returns a ReadableSet representing the set with all elements of this and the other set,
+// which are contained in exactly one of the two.
+//
+// The result is a data view and will reflect future changes of the underlying structures.
+func (universe Universe[T]) SymmetricDifference(other ReadableSet[T]) ReadableSet[T] {
+	return universe.Difference(other).Union(other.Difference(universe))
+}
+
+// Equals returns true, if this [Universe] and the other [ReadableSet] represent exactly the same elements.
+func (universe Universe[T]) Equals(other ReadableSet[T]) bool {
+	return universe.SymmetricDifference(other).Size() == 0
+}
+
+// Subset returns true, if all elements of this [Universe] are contained in the other [Container].
+func (universe Universe[T]) Subset(other Container[T]) bool {
+	return universe.Difference(other).Size() == 0
+}
+
+// StrictSubset returns true, if all elements of this [Universe] are contained in the other [ReadableSet]
+// and the sets are not equal.
+func (universe Universe[T]) StrictSubset(other ReadableSet[T]) bool {
+	return universe.Difference(other).Size() == 0 && other.Difference(universe).Size() > 0
+}
+
+// Elements returns an [Iterator] over the elements of this [Universe].
+func (universe Universe[T]) Elements() Iterator[T] {
+	return universe.elements.Elements()
+}
+
+// Size returns the number of elements in this [Universe].
+func (universe Universe[T]) Size() int {
+	return len(universe.elements)
+}
+
+// String implements [fmt.Stringer] for this [Universe].
+func (universe Universe[T]) String() string {
+	return toString[T](universe)
+}
+
+// MarshalJSON implements [json.Marshaler] for this [Universe].
+// The universe is encoded as a JSON array of its elements in no particular order.
+func (universe Universe[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](universe)
+}
+
+// SafeForConcurrentReads implements [ConcurrentReader] and returns true, since a [Universe] is immutable.
+func (universe Universe[T]) SafeForConcurrentReads() bool {
+	return true
+}
@@ -0,0 +1,89 @@
+package cantor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/frederik-jatzkowski/cantor"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/sets"
+	"github.com/frederik-jatzkowski/cantor/internal/testsuites/testutils"
+)
+
+func TestNewUniverse(t *testing.T) {
+	sets.RunTestsForReadableSet(t, func(elements ...byte) cantor.ReadableSet[byte] {
+		return cantor.NewUniverse(elements...)
+	})
+}
+
+func TestUniverse_ComplementOf(t *testing.T) {
+	universe := cantor.NewUniverse(testutils.AllBytes()...)
+
+	t.Run("readable set", func(t *testing.T) {
+		set := cantor.NewHashSet[byte](1, 2, 3)
+		complement := universe.ComplementOf(set)
+
+		if complement.Size() != 253 {
+			t.Errorf("expected 253 elements but got %d", complement.Size())
+		}
+
+		set.Remove(1)
+
+		if complement.Size() != 254 || !complement.Contains(1) {
+			t.Errorf("complement did not reflect removal of 1")
+		}
+	})
+
+	t.Run("implicit set", func(t *testing.T) {
+		even := cantor.NewImplicitSet(func(element byte) bool {
+			return element%2 == 0
+		})
+		odd := universe.ComplementOf(even)
+
+		if odd.Size() != 128 || odd.Contains(2) || !odd.Contains(3) {
+			t.Errorf("expected all 128 odd bytes but got %v", odd)
+		}
+
+		if !universe.ComplementOf(odd).Equals(universe.Intersect(even)) {
+			t.Errorf("double complement is not the original set")
+		}
+	})
+
+	t.Run("as domain", func(t *testing.T) {
+		even := cantor.NewImplicitSet(func(element byte) bool {
+			return element%2 == 0
+		})
+
+		equal, err := universe.ComplementOf(even).Complement().Equals(even, universe)
+		if err != nil || !equal {
+			t.Errorf("expected equal sets within universe but got %t (%v)", equal, err)
+		}
+	})
+
+	t.Run("parallel", func(t *testing.T) {
+		size, err := cantor.ParallelSize(universe.ComplementOf(cantor.NewHashSet[byte](1)), 2)
+		if err != nil || size != 255 {
+			t.Errorf("expected 255 but got %d (%v)", size, err)
+		}
+	})
+
+	t.Run("complement", func(t *testing.T) {
+		small := cantor.NewUniverse(1, 2)
+
+		if small.Complement().Contains(1) || !small.Complement().Contains(3) {
+			t.Errorf("Complement should contain all elements outside of the universe")
+		}
+	})
+}
+
+func ExampleUniverse_ComplementOf() {
+	regions := cantor.NewUniverse("eu-central", "eu-west", "us-east", "us-west")
+	enabled := cantor.NewHashSet("eu-central", "us-east")
+
+	disabled := regions.ComplementOf(enabled)
+
+	fmt.Println(disabled.Size())
+	fmt.Println(disabled.Contains("eu-west"), disabled.Contains("ap-south"))
+	// Output:
+	// 2
+	// true false
+}